@@ -0,0 +1,213 @@
+package stringy
+
+import "unicode/utf8"
+
+// Span is a text found by BetweenSpans together with its byte offsets in the input,
+// so input[Start:End] == Text
+type Span struct {
+	Text  string
+	Start int
+	End   int
+}
+
+// BetweenOption changes how BetweenAll and BetweenSpans match, either a MatchOption or a DelimiterOption
+type BetweenOption interface {
+	betweenOption()
+}
+
+// DelimiterOption changes how BetweenAll and BetweenSpans treat the start and end delimiters
+type DelimiterOption int
+
+// const below are the available delimiter options
+const (
+	// Nested balances start and end, so "(a (b) c)" yields "a (b) c"
+	Nested DelimiterOption = iota + 1
+	// Inclusive keeps the start and end delimiters in the result
+	Inclusive
+	// BackslashEscape ignores delimiters preceded by a backslash
+	BackslashEscape
+)
+
+// betweenOption marks MatchOption and DelimiterOption as options of BetweenAll and BetweenSpans,
+// so delimiter options can not be passed to the methods which would ignore them
+func (MatchOption) betweenOption()     {}
+func (DelimiterOption) betweenOption() {}
+
+// delimiterConfig holds the resolved options of BetweenAll and BetweenSpans
+type delimiterConfig struct {
+	matchConfig
+	nested    bool
+	inclusive bool
+	escape    bool
+}
+
+/*
+ * newDelimiterConfig is a helper function to resolve the options of BetweenAll and BetweenSpans,
+ * matching case-insensitively by default like Between.
+ * @param opts []BetweenOption
+ * @return delimiterConfig
+ */
+func newDelimiterConfig(opts []BetweenOption) delimiterConfig {
+	var cfg delimiterConfig
+	var matchOpts []MatchOption
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case MatchOption:
+			matchOpts = append(matchOpts, opt)
+		case DelimiterOption:
+			switch opt {
+			case Nested:
+				cfg.nested = true
+			case Inclusive:
+				cfg.inclusive = true
+			case BackslashEscape:
+				cfg.escape = true
+			}
+		}
+	}
+	cfg.matchConfig = newMatchConfig(true, matchOpts)
+	return cfg
+}
+
+/*
+ * BetweenAll returns every text between start and end, from left to right
+ * it can be chained on function which return StringManipulation interface
+ * Matching is case-insensitive by default, see Between. Pass Nested to balance nested
+ * delimiters, Inclusive to keep the delimiters in the result and BackslashEscape to skip
+ * delimiters preceded by a backslash. A start without matching end is ignored.
+ * @param start string
+ * @param end string
+ * @param opts ...BetweenOption MatchOption or DelimiterOption
+ * @return []string
+ * Note: If start or end is empty, it returns an empty slice.
+ * Example: "Hi {{name}}, you owe {{amount}}" => BetweenAll("{{", "}}") => []string{"name", "amount"}
+ */
+func (i *input) BetweenAll(start, end string, opts ...BetweenOption) []string {
+	spans := i.BetweenSpans(start, end, opts...)
+	values := make([]string, len(spans))
+	for idx, span := range spans {
+		values[idx] = span.Text
+	}
+	return values
+}
+
+/*
+ * BetweenSpans works like BetweenAll but also returns the position of each match
+ * it can be chained on function which return StringManipulation interface
+ * @param start string
+ * @param end string
+ * @param opts ...BetweenOption MatchOption or DelimiterOption
+ * @return []Span
+ * Example: `say "hi" and "bye"` => BetweenSpans(`"`, `"`) => []Span{{"hi", 5, 7}, {"bye", 14, 17}}
+ */
+func (i *input) BetweenSpans(start, end string, opts ...BetweenOption) []Span {
+	if i.err != nil || start == "" || end == "" {
+		return []Span{}
+	}
+
+	input := getInput(*i)
+	cfg := newDelimiterConfig(opts)
+	spans := []Span{}
+	for pos := 0; pos < len(input); {
+		open, openEnd := findDelimiter(input, start, pos, cfg)
+		if open == -1 {
+			break
+		}
+		closing, closingEnd := findClosing(input, start, end, openEnd, cfg)
+		if closing == -1 {
+			if !cfg.nested {
+				// no end after this start means there is none after any later start either
+				break
+			}
+			pos = openEnd
+			continue
+		}
+		if cfg.inclusive {
+			spans = append(spans, Span{Text: input[open:closingEnd], Start: open, End: closingEnd})
+		} else {
+			spans = append(spans, Span{Text: input[openEnd:closing], Start: openEnd, End: closing})
+		}
+		pos = closingEnd
+	}
+	return spans
+}
+
+/*
+ * findDelimiter is a helper function to find the first delimiter in input at or after
+ * byte offset from, skipping escaped runes if the config asks for it.
+ * @param input string
+ * @param delimiter string
+ * @param from int byte offset
+ * @param cfg delimiterConfig
+ * @return int byte offset of the delimiter, -1 if there is none
+ * @return int byte offset after the delimiter
+ */
+func findDelimiter(input, delimiter string, from int, cfg delimiterConfig) (int, int) {
+	for pos := from; pos < len(input); {
+		if cfg.escape && input[pos] == '\\' {
+			pos = skipEscaped(input, pos)
+			continue
+		}
+		if end, ok := matchAt(input, delimiter, pos, cfg.matchConfig); ok {
+			return pos, end
+		}
+		_, size := utf8.DecodeRuneInString(input[pos:])
+		pos += size
+	}
+	return -1, -1
+}
+
+/*
+ * findClosing is a helper function to find the end delimiter closing a start delimiter
+ * which ends at byte offset from. With nesting every further start has to be closed first.
+ * @param input string
+ * @param start string
+ * @param end string
+ * @param from int byte offset
+ * @param cfg delimiterConfig
+ * @return int byte offset of the end delimiter, -1 if there is none
+ * @return int byte offset after the end delimiter
+ */
+func findClosing(input, start, end string, from int, cfg delimiterConfig) (int, int) {
+	if !cfg.nested || start == end {
+		return findDelimiter(input, end, from, cfg)
+	}
+	depth := 1
+	for pos := from; pos < len(input); {
+		if cfg.escape && input[pos] == '\\' {
+			pos = skipEscaped(input, pos)
+			continue
+		}
+		if next, ok := matchAt(input, start, pos, cfg.matchConfig); ok {
+			depth++
+			pos = next
+			continue
+		}
+		if next, ok := matchAt(input, end, pos, cfg.matchConfig); ok {
+			depth--
+			if depth == 0 {
+				return pos, next
+			}
+			pos = next
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(input[pos:])
+		pos += size
+	}
+	return -1, -1
+}
+
+/*
+ * skipEscaped is a helper function to skip the backslash at byte offset pos and the rune it escapes.
+ * @param input string
+ * @param pos int byte offset of the backslash
+ * @return int byte offset after the escaped rune
+ */
+func skipEscaped(input string, pos int) int {
+	pos++
+	if pos < len(input) {
+		_, size := utf8.DecodeRuneInString(input[pos:])
+		pos += size
+	}
+	return pos
+}
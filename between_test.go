@@ -0,0 +1,63 @@
+package stringy
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test BetweenAll with the nesting, inclusive and escape options
+func TestInput_BetweenAll(t *testing.T) {
+	testCases := []struct {
+		name       string
+		input      string
+		start, end string
+		opts       []BetweenOption
+		expected   []string
+	}{
+		{"Placeholders", "Hi {{name}}, you owe {{amount}}", "{{", "}}", nil, []string{"name", "amount"}},
+		{"Quotes", `say "hi" and "bye"`, `"`, `"`, nil, []string{"hi", "bye"}},
+		{"EmptyContent", "()()", "(", ")", nil, []string{"", ""}},
+		{"NotNested", "(a (b) c)", "(", ")", nil, []string{"a (b"}},
+		{"Nested", "(a (b) c) (d)", "(", ")", []BetweenOption{Nested}, []string{"a (b) c", "d"}},
+		{"NestedUnbalanced", "((a) b", "(", ")", []BetweenOption{Nested}, []string{"a"}},
+		{"NestedStrayEnd", "a) (b)", "(", ")", []BetweenOption{Nested}, []string{"b"}},
+		{"NestedMultiRune", "{{ a {{ b }} }} {{c}}", "{{", "}}", []BetweenOption{Nested}, []string{" a {{ b }} ", "c"}},
+		{"Inclusive", "x [a] y [b]", "[", "]", []BetweenOption{Inclusive}, []string{"[a]", "[b]"}},
+		{"InclusiveNested", "f(g(x))", "(", ")", []BetweenOption{Nested, Inclusive}, []string{"(g(x))"}},
+		{"Escape", `"a \"quoted\" word" "b"`, `"`, `"`, []BetweenOption{BackslashEscape}, []string{`a \"quoted\" word`, "b"}},
+		{"EscapedBackslash", `"a\\" "b"`, `"`, `"`, []BetweenOption{BackslashEscape}, []string{`a\\`, "b"}},
+		{"EscapedStart", `\(a) (b)`, "(", ")", []BetweenOption{BackslashEscape}, []string{"b"}},
+		{"NoEscape", `"a \"b\""`, `"`, `"`, nil, []string{`a \`, ``}},
+		{"Unterminated", "(a) (b", "(", ")", nil, []string{"a"}},
+		{"CaseInsensitive", "BEGIN x END begin y end", "begin", "end", nil, []string{" x ", " y "}},
+		{"CaseSensitive", "BEGIN x END begin y end", "begin", "end", []BetweenOption{CaseSensitive}, []string{" y "}},
+		{"NestedCaseSensitive", "<a <A> b> <A>", "<a", ">", []BetweenOption{Nested, CaseSensitive}, []string{" <A"}},
+		{"NestedCaseInsensitive", "<a <A> b> <A>", "<a", ">", []BetweenOption{Nested}, []string{" <A> b", ""}},
+		{"Unicode", "«ä» and «ö»", "«", "»", nil, []string{"ä", "ö"}},
+		{"EmptyDelimiter", "(a)", "", ")", nil, []string{}},
+		{"NoMatch", "hello", "(", ")", nil, []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if val := New(tc.input).BetweenAll(tc.start, tc.end, tc.opts...); !reflect.DeepEqual(val, tc.expected) {
+				t.Errorf("Expected: %q but got: %q", tc.expected, val)
+			}
+		})
+	}
+}
+
+// Test BetweenSpans returns byte offsets into the input
+func TestInput_BetweenSpans(t *testing.T) {
+	input := `say "hi" and "bye"`
+	expected := []Span{{"hi", 5, 7}, {"bye", 14, 17}}
+	spans := New(input).BetweenSpans(`"`, `"`)
+	if !reflect.DeepEqual(spans, expected) {
+		t.Fatalf("Expected: %v but got: %v", expected, spans)
+	}
+
+	input = "ä (ö (ü)) ß"
+	spans = New(input).BetweenSpans("(", ")", Nested, Inclusive)
+	if len(spans) != 1 || input[spans[0].Start:spans[0].End] != spans[0].Text || spans[0].Text != "(ö (ü))" {
+		t.Errorf("Expected the span to point into the input but got: %v", spans)
+	}
+}
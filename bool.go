@@ -0,0 +1,129 @@
+package stringy
+
+import "strings"
+
+// BoolState is the tri-state result of parsing a boolean representation
+type BoolState int
+
+// const below are the possible BoolState values
+const (
+	BoolUnknown BoolState = iota
+	BoolFalse
+	BoolTrue
+)
+
+// String returns "true", "false" or "unknown"
+func (b BoolState) String() string {
+	switch b {
+	case BoolTrue:
+		return "true"
+	case BoolFalse:
+		return "false"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+ * BoolParser parses boolean representations of strings using its own vocabulary.
+ * Values are compared case-insensitively. A BoolParser is immutable once created
+ * and safe for concurrent use.
+ */
+type BoolParser struct {
+	trueSet  map[string]struct{}
+	falseSet map[string]struct{}
+	lenient  bool
+}
+
+// defaultBoolParser holds the True and False vocabularies and is used by Boolean
+var defaultBoolParser = NewBoolParser(True, False)
+
+/*
+ * NewBoolParser creates a BoolParser which recognises trueValues and falseValues.
+ * The vocabularies are copied, changing the passed slices afterwards has no effect.
+ * By default the parser is strict about whitespace, see Lenient.
+ * @param trueValues []string values representing true
+ * @param falseValues []string values representing false
+ * @return *BoolParser
+ * Example: NewBoolParser([]string{"ja", "oui"}, []string{"nein", "non"}).Parse("Ja") => true, nil
+ */
+func NewBoolParser(trueValues, falseValues []string) *BoolParser {
+	p := &BoolParser{
+		trueSet:  make(map[string]struct{}, len(trueValues)),
+		falseSet: make(map[string]struct{}, len(falseValues)),
+	}
+	for _, v := range trueValues {
+		p.trueSet[strings.ToLower(v)] = struct{}{}
+	}
+	for _, v := range falseValues {
+		p.falseSet[strings.ToLower(v)] = struct{}{}
+	}
+	return p
+}
+
+/*
+ * Lenient returns a copy of the parser which ignores leading and trailing whitespace
+ * when lenient is true, or requires an exact match when it is false.
+ * @param lenient bool
+ * @return *BoolParser
+ * Example: NewBoolParser(True, False).Lenient(true).State(" yes ") => BoolTrue
+ */
+func (p *BoolParser) Lenient(lenient bool) *BoolParser {
+	c := *p
+	c.lenient = lenient
+	return &c
+}
+
+/*
+ * State returns the tri-state value of val, BoolUnknown if it is not part of the vocabulary.
+ * @param val string
+ * @return BoolState
+ */
+func (p *BoolParser) State(val string) BoolState {
+	if p.lenient {
+		val = strings.TrimSpace(val)
+	}
+	val = strings.ToLower(val)
+	if _, ok := p.falseSet[val]; ok {
+		return BoolFalse
+	}
+	if _, ok := p.trueSet[val]; ok {
+		return BoolTrue
+	}
+	return BoolUnknown
+}
+
+/*
+ * Parse returns the boolean value of val.
+ * @param val string
+ * @return bool
+ * @return error ErrInvalidBool wrapped in *OpError if val is not part of the vocabulary
+ */
+func (p *BoolParser) Parse(val string) (bool, error) {
+	switch p.State(val) {
+	case BoolTrue:
+		return true, nil
+	case BoolFalse:
+		return false, nil
+	default:
+		return false, newOpError("Parse", val, nil, ErrInvalidBool)
+	}
+}
+
+/*
+ * ParseDefault returns the boolean value of val or def if val is not part of the vocabulary,
+ * which is handy for optional form inputs.
+ * @param val string
+ * @param def bool default value
+ * @return bool
+ */
+func (p *BoolParser) ParseDefault(val string, def bool) bool {
+	switch p.State(val) {
+	case BoolTrue:
+		return true
+	case BoolFalse:
+		return false
+	default:
+		return def
+	}
+}
@@ -0,0 +1,118 @@
+package stringy
+
+import (
+	"errors"
+	"testing"
+)
+
+// Test BoolParser - custom and localized vocabularies
+func TestBoolParser_Parse(t *testing.T) {
+	parser := NewBoolParser(
+		[]string{"y", "enabled", "ja", "oui"},
+		[]string{"n", "disabled", "nein", "non"},
+	)
+
+	testCases := []struct {
+		input    string
+		expected bool
+	}{
+		{"y", true},
+		{"Enabled", true},
+		{"JA", true},
+		{"oui", true},
+		{"N", false},
+		{"disabled", false},
+		{"Nein", false},
+		{"non", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			val, err := parser.Parse(tc.input)
+			if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+			if val != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, val)
+			}
+		})
+	}
+
+	if _, err := parser.Parse("yes"); !errors.Is(err, ErrInvalidBool) {
+		t.Errorf("Expected ErrInvalidBool but got: %v", err)
+	}
+}
+
+// Test BoolParser - strict vs lenient whitespace handling
+func TestBoolParser_Lenient(t *testing.T) {
+	strict := NewBoolParser(True, False)
+	if state := strict.State(" yes "); state != BoolUnknown {
+		t.Errorf("Strict - Expected: %v but got: %v", BoolUnknown, state)
+	}
+
+	lenient := strict.Lenient(true)
+	if state := lenient.State(" yes\t"); state != BoolTrue {
+		t.Errorf("Lenient - Expected: %v but got: %v", BoolTrue, state)
+	}
+	if state := lenient.State("\nOFF "); state != BoolFalse {
+		t.Errorf("Lenient - Expected: %v but got: %v", BoolFalse, state)
+	}
+	// the original parser is not modified
+	if state := strict.State(" yes "); state != BoolUnknown {
+		t.Errorf("Strict after Lenient - Expected: %v but got: %v", BoolUnknown, state)
+	}
+}
+
+// Test BoolParser - tri-state with defaults
+func TestBoolParser_ParseDefault(t *testing.T) {
+	parser := NewBoolParser([]string{"on"}, []string{"off"})
+	if val := parser.ParseDefault("", true); !val {
+		t.Errorf("Expected default true for empty input")
+	}
+	if val := parser.ParseDefault("maybe", false); val {
+		t.Errorf("Expected default false for unknown input")
+	}
+	if val := parser.ParseDefault("off", true); val {
+		t.Errorf("Expected false for off")
+	}
+	if BoolUnknown.String() != "unknown" || BoolTrue.String() != "true" || BoolFalse.String() != "false" {
+		t.Errorf("Unexpected BoolState strings: %v %v %v", BoolUnknown, BoolTrue, BoolFalse)
+	}
+}
+
+// Test BooleanWith and BooleanState chain methods
+func TestInput_BooleanWith(t *testing.T) {
+	parser := NewBoolParser([]string{"ja"}, []string{"nein"})
+
+	sm := New("Ja")
+	if val := sm.BooleanWith(parser); !val {
+		t.Errorf("Expected: true but got: %v", val)
+	}
+	if sm.Error() != nil {
+		t.Errorf("Expected no error but got: %v", sm.Error())
+	}
+
+	sm = New("yes")
+	if val := sm.BooleanWith(parser); val {
+		t.Errorf("Expected: false but got: %v", val)
+	}
+	if !errors.Is(sm.Error(), ErrInvalidBool) {
+		t.Errorf("Expected ErrInvalidBool but got: %v", sm.Error())
+	}
+
+	// nil parser uses the default vocabulary
+	sm = New("yes")
+	if val := sm.BooleanWith(nil); !val {
+		t.Errorf("Expected: true but got: %v", val)
+	}
+
+	sm = New("maybe")
+	if state := sm.BooleanState(nil); state != BoolUnknown {
+		t.Errorf("Expected: %v but got: %v", BoolUnknown, state)
+	}
+	if sm.Error() != nil {
+		t.Errorf("Expected no error for unknown state but got: %v", sm.Error())
+	}
+	if state := New("nein").BooleanState(parser); state != BoolFalse {
+		t.Errorf("Expected: %v but got: %v", BoolFalse, state)
+	}
+}
@@ -0,0 +1,475 @@
+package stringy
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+ * Identifier is a checksum protected identifier such as a card number or an IBAN. All
+ * identifiers share the same trio: Validate checks the format and the check digits,
+ * Normalize returns the compact canonical form and Format the human readable form.
+ * Spaces and hyphens are ignored on input. Use the predefined Luhn, IBAN, ISBN10,
+ * ISBN13, ISBN and EAN13 identifiers, any other value, e.g. Identifier{}, rejects every
+ * input as unknown identifier.
+ * Example: IBAN.Format("gb82west12345698765432") => "GB82 WEST 1234 5698 7654 32", nil
+ */
+type Identifier struct {
+	Name       string
+	validate   func(compact string) string
+	format     func(compact string) string
+	checkDigit func(payload string) (string, string)
+}
+
+// Predefined identifiers
+var (
+	// Luhn validates numbers protected by the Luhn algorithm, e.g. credit card numbers
+	Luhn = Identifier{Name: "luhn", validate: validateLuhn, format: formatCard, checkDigit: luhnCheckDigit}
+	// IBAN validates international bank account numbers with the mod 97 check and the length of the country
+	IBAN = Identifier{Name: "iban", validate: validateIBAN, format: formatIBAN, checkDigit: ibanCheckDigits}
+	// ISBN10 validates 10 digit ISBNs, the check digit may be X
+	ISBN10 = Identifier{Name: "isbn-10", validate: validateISBN10, format: formatISBN, checkDigit: isbn10CheckDigit}
+	// ISBN13 validates 13 digit ISBNs starting with 978 or 979
+	ISBN13 = Identifier{Name: "isbn-13", validate: validateISBN13, format: formatISBN, checkDigit: isbn13CheckDigit}
+	// ISBN validates both 10 and 13 digit ISBNs, check digits are computed for 13 digit ISBNs
+	ISBN = Identifier{Name: "isbn", validate: validateISBN, format: formatISBN, checkDigit: isbn13CheckDigit}
+	// EAN13 validates 13 digit European Article Numbers
+	EAN13 = Identifier{Name: "ean-13", validate: validateEAN13, format: formatEAN13, checkDigit: ean13CheckDigit}
+)
+
+// ibanLengths holds the IBAN length of each country of the SWIFT IBAN registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HN": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+	"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+	"LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28,
+	"NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22,
+	"RU": 33, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25,
+	"SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+/*
+ * compactID is a helper function to remove spaces and hyphens from an identifier and uppercase it.
+ * @param input string
+ * @return string
+ * Example: compactID("gb82 west-1234") => "GB82WEST1234"
+ */
+func compactID(input string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(input))
+}
+
+// unknownIdentifier is the reason reported by an Identifier which is not a predefined one
+const unknownIdentifier = "unknown identifier"
+
+// known is a helper function to check id is a predefined identifier and not e.g. Identifier{}
+func (id Identifier) known() bool {
+	return id.validate != nil && id.format != nil && id.checkDigit != nil
+}
+
+/*
+ * check is a helper function to validate the compact form of an identifier, rejecting it if
+ * id is not a predefined identifier.
+ * @param compact string
+ * @return string reason, empty if valid
+ */
+func (id Identifier) check(compact string) string {
+	if !id.known() {
+		return unknownIdentifier
+	}
+	return id.validate(compact)
+}
+
+/*
+ * Validate checks the format and the check digits of input.
+ * @param input string
+ * @return error *ValidationError wrapping ErrValidation, nil if input is valid
+ * Example: Luhn.Validate("4111 1111 1111 1111") => nil
+ */
+func (id Identifier) Validate(input string) error {
+	if reason := id.check(compactID(input)); reason != "" {
+		return &ValidationError{Rule: id.Name, Reason: reason}
+	}
+	return nil
+}
+
+/*
+ * Normalize validates input and returns its compact form without spaces and hyphens.
+ * @param input string
+ * @return string
+ * @return error *ValidationError wrapping ErrValidation, nil if input is valid
+ * Example: ISBN13.Normalize("978-0-306-40615-7") => "9780306406157", nil
+ */
+func (id Identifier) Normalize(input string) (string, error) {
+	compact := compactID(input)
+	if reason := id.check(compact); reason != "" {
+		return "", &ValidationError{Rule: id.Name, Reason: reason}
+	}
+	return compact, nil
+}
+
+/*
+ * Format validates input and returns its human readable form.
+ * @param input string
+ * @return string
+ * @return error *ValidationError wrapping ErrValidation, nil if input is valid
+ * Example: EAN13.Format("4006381333931") => "4 006381 333931", nil
+ */
+func (id Identifier) Format(input string) (string, error) {
+	compact, err := id.Normalize(input)
+	if err != nil {
+		return "", err
+	}
+	return id.format(compact), nil
+}
+
+/*
+ * CheckDigit computes the check digits for payload, the identifier without its check digits.
+ * For an IBAN the payload is the country code followed by the account number (BBAN).
+ * @param payload string
+ * @return string check digits
+ * @return error *ValidationError wrapping ErrValidation if payload is malformed
+ * Example: ISBN10.CheckDigit("030640615") => "2", nil
+ */
+func (id Identifier) CheckDigit(payload string) (string, error) {
+	if !id.known() {
+		return "", &ValidationError{Rule: id.Name, Reason: unknownIdentifier}
+	}
+	digit, reason := id.checkDigit(compactID(payload))
+	if reason != "" {
+		return "", &ValidationError{Rule: id.Name, Reason: reason}
+	}
+	return digit, nil
+}
+
+/*
+ * Rule returns a rule for Validate checking the identifier.
+ * @return Rule
+ * Example: New("DE89 3704 0044 0532 0130 00").Validate(IBAN.Rule()).Error() => nil
+ */
+func (id Identifier) Rule() Rule {
+	return Rule{Name: id.Name, Check: func(input string) string {
+		return id.check(compactID(input))
+	}}
+}
+
+/*
+ * checkDigits is a helper function to verify input consists of length digits.
+ * @param input string
+ * @param length int
+ * @return string reason, empty if valid
+ */
+func checkDigits(input string, length int) string {
+	if len(input) != length {
+		return fmt.Sprintf("length must be %d", length)
+	}
+	for idx := 0; idx < len(input); idx++ {
+		if input[idx] < '0' || input[idx] > '9' {
+			return fmt.Sprintf("invalid character %q", input[idx])
+		}
+	}
+	return ""
+}
+
+/*
+ * luhnSum is a helper function to compute the Luhn sum of digits, doubling every second digit
+ * from the right, starting with the last one when double is true.
+ * @param digits string
+ * @param double bool
+ * @return int
+ */
+func luhnSum(digits string, double bool) int {
+	sum := 0
+	for idx := len(digits) - 1; idx >= 0; idx-- {
+		d := int(digits[idx] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum
+}
+
+// validateLuhn is a helper function to verify the Luhn checksum of a number
+func validateLuhn(compact string) string {
+	if len(compact) < 2 {
+		return "too short"
+	}
+	if reason := checkDigits(compact, len(compact)); reason != "" {
+		return reason
+	}
+	if luhnSum(compact, false)%10 != 0 {
+		return "checksum mismatch"
+	}
+	return ""
+}
+
+// luhnCheckDigit is a helper function to compute the Luhn check digit of payload
+func luhnCheckDigit(payload string) (string, string) {
+	if reason := checkDigits(payload, len(payload)); reason != "" || payload == "" {
+		return "", "payload must be digits"
+	}
+	return string(rune('0' + (10-luhnSum(payload, true)%10)%10)), ""
+}
+
+/*
+ * formatCard is a helper function to group a card number in blocks of four digits,
+ * American Express numbers are grouped 4-6-5.
+ * @param compact string
+ * @return string
+ */
+func formatCard(compact string) string {
+	if len(compact) == 15 && (strings.HasPrefix(compact, "34") || strings.HasPrefix(compact, "37")) {
+		return compact[:4] + " " + compact[4:10] + " " + compact[10:]
+	}
+	return groupBy(compact, 4)
+}
+
+/*
+ * groupBy is a helper function to split input in space separated groups of size.
+ * @param input string
+ * @param size int
+ * @return string
+ */
+func groupBy(input string, size int) string {
+	var result strings.Builder
+	for idx := 0; idx < len(input); idx += size {
+		if idx > 0 {
+			result.WriteByte(' ')
+		}
+		end := idx + size
+		if end > len(input) {
+			end = len(input)
+		}
+		result.WriteString(input[idx:end])
+	}
+	return result.String()
+}
+
+/*
+ * ibanMod97 is a helper function to compute the remainder of the IBAN number modulo 97,
+ * letters count as two digit numbers starting with A = 10.
+ * @param input string
+ * @return int
+ * @return bool false if input contains other characters than digits and letters
+ */
+func ibanMod97(input string) (int, bool) {
+	mod := 0
+	for idx := 0; idx < len(input); idx++ {
+		c := input[idx]
+		switch {
+		case c >= '0' && c <= '9':
+			mod = (mod*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			mod = (mod*100 + int(c-'A') + 10) % 97
+		default:
+			return 0, false
+		}
+	}
+	return mod, true
+}
+
+// validateIBAN is a helper function to verify country, length and mod 97 checksum of an IBAN
+func validateIBAN(compact string) string {
+	if len(compact) < 4 {
+		return "too short"
+	}
+	length, ok := ibanLengths[compact[:2]]
+	if !ok {
+		return fmt.Sprintf("unknown country code %q", compact[:2])
+	}
+	if len(compact) != length {
+		return fmt.Sprintf("length must be %d for %s", length, compact[:2])
+	}
+	if compact[2] < '0' || compact[2] > '9' || compact[3] < '0' || compact[3] > '9' {
+		return "check digits must be digits"
+	}
+	mod, ok := ibanMod97(compact[4:] + compact[:4])
+	if !ok {
+		return "invalid character"
+	}
+	if mod != 1 {
+		return "checksum mismatch"
+	}
+	return ""
+}
+
+// ibanCheckDigits is a helper function to compute the two check digits of an IBAN
+func ibanCheckDigits(payload string) (string, string) {
+	if len(payload) < 3 {
+		return "", "payload must be a country code followed by the account number"
+	}
+	if _, ok := ibanLengths[payload[:2]]; !ok {
+		return "", fmt.Sprintf("unknown country code %q", payload[:2])
+	}
+	mod, ok := ibanMod97(payload[2:] + payload[:2] + "00")
+	if !ok {
+		return "", "invalid character"
+	}
+	return fmt.Sprintf("%02d", 98-mod), ""
+}
+
+// formatIBAN is a helper function to group an IBAN in blocks of four characters
+func formatIBAN(compact string) string {
+	return groupBy(compact, 4)
+}
+
+// validateISBN10 is a helper function to verify the mod 11 checksum of an ISBN-10
+func validateISBN10(compact string) string {
+	if len(compact) != 10 {
+		return "length must be 10"
+	}
+	if reason := checkDigits(compact[:9], 9); reason != "" {
+		return reason
+	}
+	digit, _ := isbn10CheckDigit(compact[:9])
+	if compact[9] != digit[0] {
+		return "checksum mismatch"
+	}
+	return ""
+}
+
+// isbn10CheckDigit is a helper function to compute the check digit of an ISBN-10, 10 is written as X
+func isbn10CheckDigit(payload string) (string, string) {
+	if reason := checkDigits(payload, 9); reason != "" {
+		return "", reason
+	}
+	sum := 0
+	for idx := 0; idx < 9; idx++ {
+		sum += (10 - idx) * int(payload[idx]-'0')
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return "X", ""
+	}
+	return string(rune('0' + check)), ""
+}
+
+// validateISBN13 is a helper function to verify prefix and checksum of an ISBN-13
+func validateISBN13(compact string) string {
+	if !strings.HasPrefix(compact, "978") && !strings.HasPrefix(compact, "979") {
+		return "prefix must be 978 or 979"
+	}
+	return validateEAN13(compact)
+}
+
+// isbn13CheckDigit is a helper function to compute the check digit of an ISBN-13
+func isbn13CheckDigit(payload string) (string, string) {
+	if !strings.HasPrefix(payload, "978") && !strings.HasPrefix(payload, "979") {
+		return "", "prefix must be 978 or 979"
+	}
+	return ean13CheckDigit(payload)
+}
+
+// validateISBN is a helper function to verify an ISBN-10 or an ISBN-13
+func validateISBN(compact string) string {
+	if len(compact) == 10 {
+		return validateISBN10(compact)
+	}
+	if len(compact) == 13 {
+		return validateISBN13(compact)
+	}
+	return "length must be 10 or 13"
+}
+
+/*
+ * formatISBN is a helper function to separate the prefix and the check digit of an ISBN.
+ * The registration group and registrant are not separated as that requires the ranges
+ * published by the International ISBN Agency.
+ * @param compact string
+ * @return string
+ * Example: formatISBN("9780306406157") => "978-030640615-7"
+ */
+func formatISBN(compact string) string {
+	last := len(compact) - 1
+	if len(compact) == 13 {
+		return compact[:3] + "-" + compact[3:last] + "-" + compact[last:]
+	}
+	return compact[:last] + "-" + compact[last:]
+}
+
+// validateEAN13 is a helper function to verify the checksum of an EAN-13
+func validateEAN13(compact string) string {
+	if reason := checkDigits(compact, 13); reason != "" {
+		return reason
+	}
+	digit, _ := ean13CheckDigit(compact[:12])
+	if compact[12] != digit[0] {
+		return "checksum mismatch"
+	}
+	return ""
+}
+
+// ean13CheckDigit is a helper function to compute the check digit of an EAN-13, weighting digits 1 and 3
+func ean13CheckDigit(payload string) (string, string) {
+	if reason := checkDigits(payload, 12); reason != "" {
+		return "", reason
+	}
+	sum := 0
+	for idx := 0; idx < 12; idx++ {
+		d := int(payload[idx] - '0')
+		if idx%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return string(rune('0' + (10-sum%10)%10)), ""
+}
+
+// formatEAN13 is a helper function to group an EAN-13 as printed below bar codes
+func formatEAN13(compact string) string {
+	return compact[:1] + " " + compact[1:7] + " " + compact[7:]
+}
+
+/*
+ * Normalize validates the value as identifier and replaces it with its compact form,
+ * recording a descriptive error if it is invalid.
+ * it can be chained on function which return StringManipulation interface
+ * @param id Identifier
+ * @return StringManipulation
+ * Example: "978-0-306-40615-7" => Normalize(ISBN) => "9780306406157"
+ */
+func (i *input) Normalize(id Identifier) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	compact, err := id.Normalize(input)
+	if err != nil {
+		i.err = newOpError("Normalize", input, id.Name, err)
+		setResult(i, "")
+		return i
+	}
+	setResult(i, compact)
+	return i
+}
+
+/*
+ * Format validates the value as identifier and replaces it with its human readable form,
+ * recording a descriptive error if it is invalid.
+ * it can be chained on function which return StringManipulation interface
+ * @param id Identifier
+ * @return StringManipulation
+ * Example: "de89370400440532013000" => Format(IBAN) => "DE89 3704 0044 0532 0130 00"
+ */
+func (i *input) Format(id Identifier) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	formatted, err := id.Format(input)
+	if err != nil {
+		i.err = newOpError("Format", input, id.Name, err)
+		setResult(i, "")
+		return i
+	}
+	setResult(i, formatted)
+	return i
+}
@@ -0,0 +1,163 @@
+package stringy
+
+import (
+	"errors"
+	"testing"
+)
+
+// Test Validate, Normalize and Format of the predefined identifiers
+func TestIdentifier(t *testing.T) {
+	testCases := []struct {
+		id         Identifier
+		input      string
+		normalized string
+		formatted  string
+	}{
+		{Luhn, "79927398713", "79927398713", "7992 7398 713"},
+		{Luhn, "4111-1111-1111-1111", "4111111111111111", "4111 1111 1111 1111"},
+		{Luhn, "3782 822463 10005", "378282246310005", "3782 822463 10005"},
+		{IBAN, "gb82 west 1234 5698 7654 32", "GB82WEST12345698765432", "GB82 WEST 1234 5698 7654 32"},
+		{IBAN, "DE89370400440532013000", "DE89370400440532013000", "DE89 3704 0044 0532 0130 00"},
+		{IBAN, "NO93 8601 1117 947", "NO9386011117947", "NO93 8601 1117 947"},
+		{ISBN10, "0-306-40615-2", "0306406152", "030640615-2"},
+		{ISBN10, "0-8044-2957-x", "080442957X", "080442957-X"},
+		{ISBN13, "978-0-306-40615-7", "9780306406157", "978-030640615-7"},
+		{ISBN, "0306406152", "0306406152", "030640615-2"},
+		{ISBN, "979-10-90636-07-1", "9791090636071", "979-109063607-1"},
+		{EAN13, "4006381333931", "4006381333931", "4 006381 333931"},
+	}
+	for _, tc := range testCases {
+		if err := tc.id.Validate(tc.input); err != nil {
+			t.Errorf("%s.Validate(%q) - Expected no error but got: %v", tc.id.Name, tc.input, err)
+		}
+		if val, err := tc.id.Normalize(tc.input); err != nil || val != tc.normalized {
+			t.Errorf("%s.Normalize(%q) - Expected: %s but got: %s, %v", tc.id.Name, tc.input, tc.normalized, val, err)
+		}
+		if val, err := tc.id.Format(tc.input); err != nil || val != tc.formatted {
+			t.Errorf("%s.Format(%q) - Expected: %s but got: %s, %v", tc.id.Name, tc.input, tc.formatted, val, err)
+		}
+	}
+}
+
+// Test invalid identifiers are reported with a reason
+func TestIdentifier_Invalid(t *testing.T) {
+	testCases := []struct {
+		id     Identifier
+		input  string
+		reason string
+	}{
+		{Luhn, "79927398710", "checksum mismatch"},
+		{Luhn, "4111 1111 1111 111a", "invalid character 'A'"},
+		{Luhn, "7", "too short"},
+		{IBAN, "GB82 WEST 1234 5698 7654 33", "checksum mismatch"},
+		{IBAN, "GB82 WEST 1234 5698 7654", "length must be 22 for GB"},
+		{IBAN, "XX82 WEST 1234 5698 7654 32", `unknown country code "XX"`},
+		{IBAN, "GBAB WEST 1234 5698 7654 32", "check digits must be digits"},
+		{IBAN, "GB82 WEST 1234 5698 7654 3_", "invalid character"},
+		{ISBN10, "0-306-40615-3", "checksum mismatch"},
+		{ISBN10, "0-306-40615", "length must be 10"},
+		{ISBN13, "977-0-306-40615-7", "prefix must be 978 or 979"},
+		{ISBN, "12345", "length must be 10 or 13"},
+		{EAN13, "4006381333932", "checksum mismatch"},
+	}
+	for _, tc := range testCases {
+		err := tc.id.Validate(tc.input)
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Reason != tc.reason || validationErr.Rule != tc.id.Name {
+			t.Errorf("%s.Validate(%q) - Expected reason: %s but got: %v", tc.id.Name, tc.input, tc.reason, err)
+		}
+		if !errors.Is(err, ErrValidation) {
+			t.Errorf("%s.Validate(%q) - Expected errors.Is(ErrValidation) but got: %v", tc.id.Name, tc.input, err)
+		}
+		if _, err := tc.id.Normalize(tc.input); err == nil {
+			t.Errorf("%s.Normalize(%q) - Expected an error", tc.id.Name, tc.input)
+		}
+	}
+}
+
+// Test check digit computation
+func TestIdentifier_CheckDigit(t *testing.T) {
+	testCases := []struct {
+		id       Identifier
+		payload  string
+		expected string
+	}{
+		{Luhn, "7992739871", "3"},
+		{Luhn, "411111111111111", "1"},
+		{IBAN, "GB WEST 1234 5698 7654 32", "82"},
+		{IBAN, "DE370400440532013000", "89"},
+		{ISBN10, "030640615", "2"},
+		{ISBN10, "080442957", "X"},
+		{ISBN13, "978030640615", "7"},
+		{EAN13, "400638133393", "1"},
+	}
+	for _, tc := range testCases {
+		if val, err := tc.id.CheckDigit(tc.payload); err != nil || val != tc.expected {
+			t.Errorf("%s.CheckDigit(%q) - Expected: %s but got: %s, %v", tc.id.Name, tc.payload, tc.expected, val, err)
+		}
+	}
+	if _, err := EAN13.CheckDigit("12345"); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected errors.Is(ErrValidation) but got: %v", err)
+	}
+}
+
+// Test identifiers which are not predefined are rejected instead of panicking
+func TestIdentifier_Unknown(t *testing.T) {
+	for _, id := range []Identifier{{}, {Name: "card"}} {
+		if err := id.Validate("4111111111111111"); !errors.Is(err, ErrValidation) {
+			t.Errorf("Validate - Expected errors.Is(ErrValidation) but got: %v", err)
+		}
+		if _, err := id.Normalize("4111111111111111"); !errors.Is(err, ErrValidation) {
+			t.Errorf("Normalize - Expected errors.Is(ErrValidation) but got: %v", err)
+		}
+		if _, err := id.Format("4111111111111111"); !errors.Is(err, ErrValidation) {
+			t.Errorf("Format - Expected errors.Is(ErrValidation) but got: %v", err)
+		}
+		if _, err := id.CheckDigit("411111111111111"); !errors.Is(err, ErrValidation) {
+			t.Errorf("CheckDigit - Expected errors.Is(ErrValidation) but got: %v", err)
+		}
+		if err := New("4111111111111111").Validate(id.Rule()).Error(); !errors.Is(err, ErrValidation) {
+			t.Errorf("Rule - Expected errors.Is(ErrValidation) but got: %v", err)
+		}
+		if err := New("4111111111111111").Format(id).Error(); !errors.Is(err, ErrValidation) {
+			t.Errorf("Format chain - Expected errors.Is(ErrValidation) but got: %v", err)
+		}
+		if err := Of("4111111111111111").Normalize(id).Error(); !errors.Is(err, ErrValidation) {
+			t.Errorf("Normalize chain - Expected errors.Is(ErrValidation) but got: %v", err)
+		}
+	}
+	expected := "stringy: Format(card): invalid card: unknown identifier"
+	if err := New("4111111111111111").Format(Identifier{Name: "card"}).Error(); err == nil || err.Error() != expected {
+		t.Errorf("Expected: %s but got: %v", expected, err)
+	}
+}
+
+// Test the Normalize and Format chain methods
+func TestInput_NormalizeFormat(t *testing.T) {
+	if val := New(" 978-0-306-40615-7 ").Trim().Normalize(ISBN).Get(); val != "9780306406157" {
+		t.Errorf("Expected: 9780306406157 but got: %s", val)
+	}
+	if val := New("de89370400440532013000").Format(IBAN).Get(); val != "DE89 3704 0044 0532 0130 00" {
+		t.Errorf("Expected: DE89 3704 0044 0532 0130 00 but got: %s", val)
+	}
+	if val := Of("4111111111111111").Format(Luhn).Get(); val != "4111 1111 1111 1111" {
+		t.Errorf("Expected: 4111 1111 1111 1111 but got: %s", val)
+	}
+
+	str := New("4111 1111 1111 1112").Format(Luhn)
+	err := str.Error()
+	expected := "stringy: Format(luhn): invalid luhn: checksum mismatch"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected: %s but got: %v", expected, err)
+	}
+	if val := str.Get(); val != "" {
+		t.Errorf("Expected empty result but got: %s", val)
+	}
+
+	if err := New("DE89 3704 0044 0532 0130 00").Validate(IBAN.Rule()).Error(); err != nil {
+		t.Errorf("Expected no error but got: %v", err)
+	}
+	if err := New("DE89 3704 0044 0532 0130 01").Validate(IBAN.Rule()).Error(); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected errors.Is(ErrValidation) but got: %v", err)
+	}
+}
@@ -0,0 +1,416 @@
+package stringy
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// numberReplacer removes the thousands separators tolerated in numbers
+var numberReplacer = strings.NewReplacer(",", "", "_", "", " ", "")
+
+// numberSuffixes holds the SI multipliers accepted after numbers, e.g. "1.5k"
+var numberSuffixes = map[string]float64{
+	"k": 1e3,
+	"K": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+	"T": 1e12,
+}
+
+// byteUnits holds SI (powers of 1000) and IEC (powers of 1024) byte size units by lowercase name
+var byteUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"k":   1e3,
+	"kb":  1e3,
+	"m":   1e6,
+	"mb":  1e6,
+	"g":   1e9,
+	"gb":  1e9,
+	"t":   1e12,
+	"tb":  1e12,
+	"p":   1e15,
+	"pb":  1e15,
+	"ki":  1 << 10,
+	"kib": 1 << 10,
+	"mi":  1 << 20,
+	"mib": 1 << 20,
+	"gi":  1 << 30,
+	"gib": 1 << 30,
+	"ti":  1 << 40,
+	"tib": 1 << 40,
+	"pi":  1 << 50,
+	"pib": 1 << 50,
+}
+
+// durationDaysRegexp selects day and week values which time.ParseDuration does not support
+var durationDaysRegexp = regexp.MustCompile(`([0-9]*\.?[0-9]+)([dw])`)
+
+/*
+ * splitNumber is a helper function to split a cleaned value into its numeric part and unit.
+ * @param val string
+ * @return number string leading number
+ * @return unit string remaining unit
+ */
+func splitNumber(val string) (number, unit string) {
+	idx := strings.IndexFunc(val, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+	})
+	if idx == -1 {
+		return val, ""
+	}
+	return val[:idx], val[idx:]
+}
+
+/*
+ * parseFloat is a helper function to parse a number which may contain thousands separators
+ * ("1,024", "1_000") and an SI suffix ("1.5k", "2M").
+ * @param val string
+ * @return float64
+ * @return error ErrInvalidNumber or ErrOutOfRange
+ */
+func parseFloat(val string) (float64, error) {
+	val = numberReplacer.Replace(strings.TrimSpace(val))
+	multiplier := 1.0
+	if n := len(val); n > 1 {
+		if m, ok := numberSuffixes[val[n-1:]]; ok {
+			multiplier = m
+			val = val[:n-1]
+		}
+	}
+	f, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+			return 0, ErrOutOfRange
+		}
+		return 0, ErrInvalidNumber
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, ErrInvalidNumber
+	}
+	if math.IsInf(f*multiplier, 0) {
+		return 0, ErrOutOfRange
+	}
+	return f * multiplier, nil
+}
+
+/*
+ * parseInt is a helper function to parse an integer with the same tolerance as parseFloat.
+ * Values with a suffix must still resolve to a whole number, "1.5k" is valid but "1.5" is not.
+ * @param val string
+ * @return int64
+ * @return error ErrInvalidNumber or ErrOutOfRange
+ */
+func parseInt(val string) (int64, error) {
+	cleaned := numberReplacer.Replace(strings.TrimSpace(val))
+	if n, err := strconv.ParseInt(cleaned, 10, 64); err == nil {
+		return n, nil
+	} else if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+		return 0, ErrOutOfRange
+	}
+	f, err := parseFloat(cleaned)
+	if err != nil {
+		return 0, err
+	}
+	if f != math.Trunc(f) {
+		return 0, ErrInvalidNumber
+	}
+	if f >= math.MaxInt64 || f < math.MinInt64 {
+		return 0, ErrOutOfRange
+	}
+	return int64(f), nil
+}
+
+/*
+ * parseDuration is a helper function to parse a duration like time.ParseDuration which also
+ * accepts spaces (" 5 m "), days ("2d"), weeks ("1w") and plain numbers as seconds ("90").
+ * @param val string
+ * @return time.Duration
+ * @return error ErrInvalidDuration or ErrOutOfRange
+ */
+func parseDuration(val string) (time.Duration, error) {
+	val = strings.ReplaceAll(strings.TrimSpace(val), " ", "")
+	if val == "" {
+		return 0, ErrInvalidDuration
+	}
+	if seconds, err := strconv.ParseFloat(val, 64); err == nil && !math.IsNaN(seconds) && !math.IsInf(seconds, 0) {
+		ns := seconds * float64(time.Second)
+		if !durationInRange(ns) {
+			return 0, ErrOutOfRange
+		}
+		return time.Duration(ns), nil
+	}
+	outOfRange := false
+	val = durationDaysRegexp.ReplaceAllStringFunc(val, func(match string) string {
+		parts := durationDaysRegexp.FindStringSubmatch(match)
+		hours, _ := strconv.ParseFloat(parts[1], 64)
+		if parts[2] == "w" {
+			hours *= 7
+		}
+		if !durationInRange(hours * 24 * float64(time.Hour)) {
+			outOfRange = true
+		}
+		return strconv.FormatFloat(hours*24, 'f', -1, 64) + "h"
+	})
+	if outOfRange {
+		return 0, ErrOutOfRange
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, ErrInvalidDuration
+	}
+	return d, nil
+}
+
+/*
+ * durationInRange is a helper function to check if ns nanoseconds fit a time.Duration.
+ * @param ns float64
+ * @return bool
+ */
+func durationInRange(ns float64) bool {
+	return ns < math.MaxInt64 && ns >= math.MinInt64
+}
+
+/*
+ * parseByteSize is a helper function to parse a byte size with an optional SI ("10 MB", "1.5k")
+ * or IEC ("10 MiB", "4Ki") unit. Units are case-insensitive and fractional bytes are rounded.
+ * @param val string
+ * @return int64 number of bytes
+ * @return error ErrInvalidByteSize or ErrOutOfRange
+ */
+func parseByteSize(val string) (int64, error) {
+	number, unit := splitNumber(numberReplacer.Replace(strings.TrimSpace(val)))
+	multiplier, ok := byteUnits[strings.ToLower(unit)]
+	if !ok || number == "" {
+		return 0, ErrInvalidByteSize
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil || f < 0 {
+		return 0, ErrInvalidByteSize
+	}
+	size := math.Round(f * multiplier)
+	if size >= math.MaxInt64 {
+		return 0, ErrOutOfRange
+	}
+	return int64(size), nil
+}
+
+/*
+ * parsePercent is a helper function to parse a percentage ("12.5%", " 50 % ", "50") into a fraction.
+ * @param val string
+ * @return float64 fraction, e.g. 0.125 for "12.5%"
+ * @return error ErrInvalidNumber or ErrOutOfRange
+ */
+func parsePercent(val string) (float64, error) {
+	val = strings.TrimSuffix(numberReplacer.Replace(strings.TrimSpace(val)), "%")
+	if val == "" || strings.IndexAny(val[len(val)-1:], "0123456789.") == -1 {
+		// SI suffixes are not meaningful for percentages
+		return 0, ErrInvalidNumber
+	}
+	f, err := parseFloat(val)
+	if err != nil {
+		return 0, err
+	}
+	return f / 100, nil
+}
+
+/*
+* Int returns the integer value of the input. Surrounding whitespace, thousands separators
+* ("1,024", "1_000") and SI suffixes ("1.5k", "2M") are accepted.
+* it can be chained on function which return StringManipulation interface
+* @return int64
+* Note: If the input is not a valid integer, it returns 0 and sets an error.
+* Example: " 1,024 " => 1024, "1.5k" => 1500
+ */
+func (i *input) Int() int64 {
+	if i.err != nil {
+		return 0
+	}
+
+	input := getInput(*i)
+	n, err := parseInt(input)
+	if err != nil {
+		i.err = newOpError("Int", input, nil, err)
+		return 0
+	}
+	return n
+}
+
+/*
+* IntOrDefault returns the integer value of the input like Int or def if it is not valid.
+* No error is recorded.
+* @param def int64 default value
+* @return int64
+ */
+func (i *input) IntOrDefault(def int64) int64 {
+	if i.err != nil {
+		return def
+	}
+
+	n, err := parseInt(getInput(*i))
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+/*
+* Float returns the floating point value of the input with the same tolerance as Int.
+* it can be chained on function which return StringManipulation interface
+* @return float64
+* Note: If the input is not a valid number, it returns 0 and sets an error.
+* Example: "1,234.5" => 1234.5, "2.5M" => 2500000
+ */
+func (i *input) Float() float64 {
+	if i.err != nil {
+		return 0
+	}
+
+	input := getInput(*i)
+	f, err := parseFloat(input)
+	if err != nil {
+		i.err = newOpError("Float", input, nil, err)
+		return 0
+	}
+	return f
+}
+
+/*
+* FloatOrDefault returns the floating point value of the input like Float or def if it is not valid.
+* No error is recorded.
+* @param def float64 default value
+* @return float64
+ */
+func (i *input) FloatOrDefault(def float64) float64 {
+	if i.err != nil {
+		return def
+	}
+
+	f, err := parseFloat(getInput(*i))
+	if err != nil {
+		return def
+	}
+	return f
+}
+
+/*
+* Duration returns the time.Duration value of the input. On top of the time.ParseDuration
+* format it accepts spaces, days ("d"), weeks ("w") and plain numbers as seconds.
+* it can be chained on function which return StringManipulation interface
+* @return time.Duration
+* Note: If the input is not a valid duration, it returns 0 and sets an error.
+* Example: " 5m " => 5m0s, "1d 12h" => 36h0m0s, "90" => 1m30s
+ */
+func (i *input) Duration() time.Duration {
+	if i.err != nil {
+		return 0
+	}
+
+	input := getInput(*i)
+	d, err := parseDuration(input)
+	if err != nil {
+		i.err = newOpError("Duration", input, nil, err)
+		return 0
+	}
+	return d
+}
+
+/*
+* DurationOrDefault returns the duration value of the input like Duration or def if it is not valid.
+* No error is recorded.
+* @param def time.Duration default value
+* @return time.Duration
+ */
+func (i *input) DurationOrDefault(def time.Duration) time.Duration {
+	if i.err != nil {
+		return def
+	}
+
+	d, err := parseDuration(getInput(*i))
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+/*
+* ByteSize returns the number of bytes of a size with an optional SI or IEC unit.
+* SI units (k, KB, MB, ...) are powers of 1000, IEC units (Ki, KiB, MiB, ...) powers of 1024.
+* it can be chained on function which return StringManipulation interface
+* @return int64
+* Note: If the input is not a valid size, it returns 0 and sets an error.
+* Example: "10 MiB" => 10485760, "1.5k" => 1500, "1,024" => 1024
+ */
+func (i *input) ByteSize() int64 {
+	if i.err != nil {
+		return 0
+	}
+
+	input := getInput(*i)
+	size, err := parseByteSize(input)
+	if err != nil {
+		i.err = newOpError("ByteSize", input, nil, err)
+		return 0
+	}
+	return size
+}
+
+/*
+* ByteSizeOrDefault returns the byte size of the input like ByteSize or def if it is not valid.
+* No error is recorded.
+* @param def int64 default value
+* @return int64
+ */
+func (i *input) ByteSizeOrDefault(def int64) int64 {
+	if i.err != nil {
+		return def
+	}
+
+	size, err := parseByteSize(getInput(*i))
+	if err != nil {
+		return def
+	}
+	return size
+}
+
+/*
+* Percent returns the fraction represented by a percentage, the "%" sign is optional.
+* it can be chained on function which return StringManipulation interface
+* @return float64
+* Note: If the input is not a valid percentage, it returns 0 and sets an error.
+* Example: "12.5%" => 0.125, " 50 % " => 0.5
+ */
+func (i *input) Percent() float64 {
+	if i.err != nil {
+		return 0
+	}
+
+	input := getInput(*i)
+	f, err := parsePercent(input)
+	if err != nil {
+		i.err = newOpError("Percent", input, nil, err)
+		return 0
+	}
+	return f
+}
+
+/*
+* PercentOrDefault returns the fraction of the input like Percent or def if it is not valid.
+* No error is recorded.
+* @param def float64 default value
+* @return float64
+ */
+func (i *input) PercentOrDefault(def float64) float64 {
+	if i.err != nil {
+		return def
+	}
+
+	f, err := parsePercent(getInput(*i))
+	if err != nil {
+		return def
+	}
+	return f
+}
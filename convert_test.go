@@ -0,0 +1,201 @@
+package stringy
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// Test Int conversion
+func TestInput_Int(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected int64
+		err      error
+	}{
+		{"42", 42, nil},
+		{" -17 ", -17, nil},
+		{"1,024", 1024, nil},
+		{"1_000_000", 1000000, nil},
+		{"1.5k", 1500, nil},
+		{"2M", 2000000, nil},
+		{"1.5", 0, ErrInvalidNumber},
+		{"abc", 0, ErrInvalidNumber},
+		{"", 0, ErrInvalidNumber},
+		{"99999999999999999999", 0, ErrOutOfRange},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			sm := New(tc.input)
+			if val := sm.Int(); val != tc.expected {
+				t.Errorf("Expected: %d but got: %d", tc.expected, val)
+			}
+			if !errors.Is(sm.Error(), tc.err) {
+				t.Errorf("Expected error: %v but got: %v", tc.err, sm.Error())
+			}
+		})
+	}
+	if val := New("n/a").IntOrDefault(7); val != 7 {
+		t.Errorf("Expected default: 7 but got: %d", val)
+	}
+	if val := New("8").IntOrDefault(7); val != 8 {
+		t.Errorf("Expected: 8 but got: %d", val)
+	}
+}
+
+// Test Float conversion
+func TestInput_Float(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected float64
+		err      error
+	}{
+		{"3.14", 3.14, nil},
+		{" 1,234.5 ", 1234.5, nil},
+		{"2.5M", 2500000, nil},
+		{"1e3", 1000, nil},
+		{"NaN", 0, ErrInvalidNumber},
+		{"one", 0, ErrInvalidNumber},
+		{"1e308k", 0, ErrOutOfRange},
+		{"-1e308G", 0, ErrOutOfRange},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			sm := New(tc.input)
+			if val := sm.Float(); val != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, val)
+			}
+			if !errors.Is(sm.Error(), tc.err) {
+				t.Errorf("Expected error: %v but got: %v", tc.err, sm.Error())
+			}
+		})
+	}
+	if val := New("").FloatOrDefault(0.5); val != 0.5 {
+		t.Errorf("Expected default: 0.5 but got: %v", val)
+	}
+	if val := New("1e308k").FloatOrDefault(7); val != 7 {
+		t.Errorf("Expected default for an out of range value: 7 but got: %v", val)
+	}
+}
+
+// Test Duration conversion
+func TestInput_Duration(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected time.Duration
+		err      error
+	}{
+		{" 5m ", 5 * time.Minute, nil},
+		{"1h 30m", 90 * time.Minute, nil},
+		{"2d", 48 * time.Hour, nil},
+		{"1w1d", 8 * 24 * time.Hour, nil},
+		{"1.5d", 36 * time.Hour, nil},
+		{"90", 90 * time.Second, nil},
+		{"250ms", 250 * time.Millisecond, nil},
+		{"soon", 0, ErrInvalidDuration},
+		{"", 0, ErrInvalidDuration},
+		{"1e20", 0, ErrOutOfRange},
+		{"-1e20", 0, ErrOutOfRange},
+		{"99999999999999999999", 0, ErrOutOfRange},
+		{"200000000d", 0, ErrOutOfRange},
+		{"20000000w", 0, ErrOutOfRange},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			sm := New(tc.input)
+			if val := sm.Duration(); val != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, val)
+			}
+			if !errors.Is(sm.Error(), tc.err) {
+				t.Errorf("Expected error: %v but got: %v", tc.err, sm.Error())
+			}
+		})
+	}
+	if val := New("later").DurationOrDefault(time.Second); val != time.Second {
+		t.Errorf("Expected default: 1s but got: %v", val)
+	}
+	if val := New("99999999999999999999").DurationOrDefault(time.Second); val != time.Second {
+		t.Errorf("Expected default for an out of range value: 1s but got: %v", val)
+	}
+}
+
+// Test ByteSize conversion
+func TestInput_ByteSize(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected int64
+		err      error
+	}{
+		{"512", 512, nil},
+		{"512B", 512, nil},
+		{"1,024", 1024, nil},
+		{"10 MiB", 10 << 20, nil},
+		{"4Ki", 4096, nil},
+		{"1.5k", 1500, nil},
+		{"2 GB", 2000000000, nil},
+		{"1.5 kib", 1536, nil},
+		{"-1KB", 0, ErrInvalidByteSize},
+		{"10 parsecs", 0, ErrInvalidByteSize},
+		{"MB", 0, ErrInvalidByteSize},
+		{"100000 PB", 0, ErrOutOfRange},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			sm := New(tc.input)
+			if val := sm.ByteSize(); val != tc.expected {
+				t.Errorf("Expected: %d but got: %d", tc.expected, val)
+			}
+			if !errors.Is(sm.Error(), tc.err) {
+				t.Errorf("Expected error: %v but got: %v", tc.err, sm.Error())
+			}
+		})
+	}
+	if val := New("lots").ByteSizeOrDefault(1 << 20); val != 1<<20 {
+		t.Errorf("Expected default: %d but got: %d", 1<<20, val)
+	}
+}
+
+// Test Percent conversion
+func TestInput_Percent(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected float64
+		err      error
+	}{
+		{"12.5%", 0.125, nil},
+		{" 50 % ", 0.5, nil},
+		{"200", 2, nil},
+		{"5k%", 0, ErrInvalidNumber},
+		{"%", 0, ErrInvalidNumber},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			sm := New(tc.input)
+			if val := sm.Percent(); val != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, val)
+			}
+			if !errors.Is(sm.Error(), tc.err) {
+				t.Errorf("Expected error: %v but got: %v", tc.err, sm.Error())
+			}
+		})
+	}
+	if val := New("half").PercentOrDefault(0.5); val != 0.5 {
+		t.Errorf("Expected default: 0.5 but got: %v", val)
+	}
+}
+
+// Test conversion errors are reported as *OpError
+func TestInput_ConvertOpError(t *testing.T) {
+	sm := New("ten")
+	sm.Int()
+	var opErr *OpError
+	if !errors.As(sm.Error(), &opErr) || opErr.Op != "Int" || opErr.Input != "ten" {
+		t.Errorf("Expected *OpError for Int but got: %v", sm.Error())
+	}
+	// OrDefault variants do not record errors
+	sm = New("ten")
+	sm.IntOrDefault(10)
+	if sm.Error() != nil {
+		t.Errorf("Expected no error but got: %v", sm.Error())
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !stringydebug
+// +build !stringydebug
+
+package stringy
+
+// debugLifecycle enables use after Release detection, see debug_on.go
+const debugLifecycle = false
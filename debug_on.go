@@ -0,0 +1,8 @@
+//go:build stringydebug
+// +build stringydebug
+
+package stringy
+
+// debugLifecycle enables use after Release detection. Released objects are kept
+// out of the pool and any further use of them panics with UseAfterReleaseError.
+const debugLifecycle = true
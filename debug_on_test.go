@@ -0,0 +1,37 @@
+//go:build stringydebug
+// +build stringydebug
+
+package stringy
+
+import "testing"
+
+// expectReleasePanic fails the test if fn does not panic with UseAfterReleaseError
+func expectReleasePanic(t *testing.T, name string, fn func()) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r != UseAfterReleaseError {
+			t.Errorf("%s - Expected panic %q but got: %v", name, UseAfterReleaseError, r)
+		}
+	}()
+	fn()
+}
+
+// Test use after Release detection
+func TestInput_UseAfterRelease(t *testing.T) {
+	str := New("hello world")
+	str.Release()
+
+	expectReleasePanic(t, "Get", func() { str.Get() })
+	expectReleasePanic(t, "Error", func() { _ = str.Error() })
+	expectReleasePanic(t, "SnakeCase", func() { str.SnakeCase() })
+	expectReleasePanic(t, "Release", func() { str.Release() })
+
+	// released objects are not handed out again
+	fresh := New("fresh")
+	if fresh == str {
+		t.Errorf("Expected released object to stay out of the pool")
+	}
+	if val := fresh.Get(); val != "fresh" {
+		t.Errorf("Expected: %s but got: %s", "fresh", val)
+	}
+}
@@ -0,0 +1,78 @@
+package stringy
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by string manipulation methods. They are wrapped in an
+// *OpError, use errors.Is to test for them.
+var (
+	// ErrOddRule is returned when a rule slice with an odd number of elements is passed
+	ErrOddRule = errors.New(OddError)
+	// ErrLength is returned when the requested length is greater than the input length
+	ErrLength = errors.New(LengthError)
+	// ErrInvalidBool is returned when the input is not a known boolean representation
+	ErrInvalidBool = errors.New(InvalidLogicalString)
+	// ErrNegativeLength is returned when a negative length is passed
+	ErrNegativeLength = errors.New(NegativeLengthError)
+	// ErrInvalidRange is returned when a start position is greater than the end position
+	ErrInvalidRange = errors.New(InvalidRangeError)
+	// ErrInvalidNumber is returned when the input can not be converted to a number
+	ErrInvalidNumber = errors.New(InvalidNumberError)
+	// ErrInvalidDuration is returned when the input can not be converted to a duration
+	ErrInvalidDuration = errors.New(InvalidDurationError)
+	// ErrInvalidByteSize is returned when the input can not be converted to a byte size
+	ErrInvalidByteSize = errors.New(InvalidByteSizeError)
+	// ErrOutOfRange is returned when a converted value does not fit its type
+	ErrOutOfRange = errors.New(OutOfRangeError)
+	// ErrInvalidRoman is returned when the input is not a valid roman numeral
+	ErrInvalidRoman = errors.New(InvalidRomanError)
+	// ErrLengthMismatch is returned when two strings must have the same length but do not
+	ErrLengthMismatch = errors.New(LengthMismatchError)
+	// ErrEmptyCharset is returned when random characters are requested from an empty charset
+	ErrEmptyCharset = errors.New(EmptyCharsetError)
+	// ErrValidation is returned when the input does not satisfy a validation rule, see ValidationError
+	ErrValidation = errors.New(ValidationFailedError)
+	// ErrInvalidPattern is returned when a regular expression or glob pattern can not be compiled, see PatternError
+	ErrInvalidPattern = errors.New(InvalidPatternError)
+	// ErrMissingKey is returned when a strict interpolation finds placeholders without a value
+	ErrMissingKey = errors.New(MissingKeyError)
+)
+
+/*
+ * OpError records the failed operation together with the input it was applied to
+ * and the offending argument. It wraps one of the sentinel errors so both
+ * errors.Is(err, ErrLength) and errors.As(err, &opErr) work.
+ */
+type OpError struct {
+	Op    string      // method which failed, e.g. "First"
+	Input string      // input the method was applied to
+	Arg   interface{} // offending argument, nil if the input itself was invalid
+	Err   error       // underlying error
+}
+
+// Error returns the error message prefixed with the failed operation
+func (e *OpError) Error() string {
+	if e.Arg == nil {
+		return fmt.Sprintf("stringy: %s: %v", e.Op, e.Err)
+	}
+	return fmt.Sprintf("stringy: %s(%v): %v", e.Op, e.Arg, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *OpError) Unwrap() error {
+	return e.Err
+}
+
+/*
+ * newOpError is a helper function to build an *OpError for a failed operation.
+ * @param op string name of the method
+ * @param input string input the method was applied to
+ * @param arg interface{} offending argument
+ * @param err error underlying error
+ * @return *OpError
+ */
+func newOpError(op, input string, arg interface{}, err error) *OpError {
+	return &OpError{Op: op, Input: input, Arg: arg, Err: err}
+}
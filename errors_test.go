@@ -0,0 +1,237 @@
+package stringy
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Test sentinel errors and OpError details for every failing operation
+func TestOpError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		run      func(sm StringManipulation)
+		input    string
+		sentinel error
+		op       string
+		arg      interface{}
+	}{
+		{"Boolean", func(sm StringManipulation) { sm.Boolean() }, "maybe", ErrInvalidBool, "Boolean", nil},
+		{"CamelCase", func(sm StringManipulation) { sm.CamelCase("%") }, "hello world", ErrOddRule, "CamelCase", []string{"%"}},
+		{"Delimited", func(sm StringManipulation) { sm.Delimited(".", "%") }, "hello world", ErrOddRule, "Delimited", []string{"%"}},
+		{"KebabCase", func(sm StringManipulation) { sm.KebabCase("%") }, "hello world", ErrOddRule, "KebabCase", []string{"%"}},
+		{"PascalCase", func(sm StringManipulation) { sm.PascalCase("%") }, "hello world", ErrOddRule, "PascalCase", []string{"%"}},
+		{"SentenceCase", func(sm StringManipulation) { sm.SentenceCase("%") }, "hello world", ErrOddRule, "SentenceCase", []string{"%"}},
+		{"SnakeCase", func(sm StringManipulation) { sm.SnakeCase("%") }, "hello world", ErrOddRule, "SnakeCase", []string{"%"}},
+		{"First", func(sm StringManipulation) { sm.First(20) }, "hello", ErrLength, "First", 20},
+		{"FirstNegative", func(sm StringManipulation) { sm.First(-1) }, "hello", ErrNegativeLength, "First", -1},
+		{"Last", func(sm StringManipulation) { sm.Last(20) }, "hello", ErrLength, "Last", 20},
+		{"LastNegative", func(sm StringManipulation) { sm.Last(-1) }, "hello", ErrNegativeLength, "Last", -1},
+		{"Substring", func(sm StringManipulation) { sm.Substring(3, 1) }, "hello", ErrInvalidRange, "Substring", []int{3, 1}},
+		{"Mask", func(sm StringManipulation) { sm.Mask(-1, 2, '*') }, "secret", ErrNegativeLength, "Mask", []int{-1, 2}},
+		{"Match", func(sm StringManipulation) { sm.Match("a(b") }, "hello", ErrInvalidPattern, "Match", "a(b"},
+		{"Glob", func(sm StringManipulation) { sm.Glob("[a-z") }, "hello", ErrInvalidPattern, "Glob", "[a-z"},
+		{"Interpolate", func(sm StringManipulation) { sm.Interpolate(nil, Strict) }, "Hi {name} ${id}", ErrMissingKey, "Interpolate", []string{"name", "id"}},
+		{"ReplaceRegex", func(sm StringManipulation) { sm.ReplaceRegex("[", "x") }, "hello", ErrInvalidPattern, "ReplaceRegex", "["},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sm := New(tc.input)
+			tc.run(sm)
+			err := sm.Error()
+			if !errors.Is(err, tc.sentinel) {
+				t.Fatalf("Expected errors.Is(%v) but got: %v", tc.sentinel, err)
+			}
+			var opErr *OpError
+			if !errors.As(err, &opErr) {
+				t.Fatalf("Expected *OpError but got: %T", err)
+			}
+			if opErr.Op != tc.op {
+				t.Errorf("Expected Op: %s but got: %s", tc.op, opErr.Op)
+			}
+			if opErr.Input != tc.input {
+				t.Errorf("Expected Input: %s but got: %s", tc.input, opErr.Input)
+			}
+			if !reflect.DeepEqual(opErr.Arg, tc.arg) {
+				t.Errorf("Expected Arg: %v but got: %v", tc.arg, opErr.Arg)
+			}
+		})
+	}
+}
+
+// Test OpError message format
+func TestOpError_Error(t *testing.T) {
+	err := newOpError("First", "hello", 20, ErrLength)
+	expected := "stringy: First(20): " + LengthError
+	if err.Error() != expected {
+		t.Errorf("Expected: %s but got: %s", expected, err.Error())
+	}
+
+	err = newOpError("Boolean", "maybe", nil, ErrInvalidBool)
+	expected = "stringy: Boolean: " + InvalidLogicalString
+	if err.Error() != expected {
+		t.Errorf("Expected: %s but got: %s", expected, err.Error())
+	}
+}
+
+// Test every method respects an error recorded by a previous step
+func TestInput_ErrorPropagation(t *testing.T) {
+	failed := func() StringManipulation {
+		return New("Hello World").CamelCase("%")
+	}
+
+	chains := map[string]func(sm StringManipulation) StringManipulation{
+		"Acronym":          func(sm StringManipulation) StringManipulation { return sm.Acronym() },
+		"Between":          func(sm StringManipulation) StringManipulation { return sm.Between("", "") },
+		"CamelCase":        func(sm StringManipulation) StringManipulation { return sm.CamelCase() },
+		"Clone":            func(sm StringManipulation) StringManipulation { return sm.Clone() },
+		"Delimited":        func(sm StringManipulation) StringManipulation { return sm.Delimited(".") },
+		"Format":           func(sm StringManipulation) StringManipulation { return sm.Format(IBAN) },
+		"ExpandEnv":        func(sm StringManipulation) StringManipulation { return sm.ExpandEnv() },
+		"FromRoman":        func(sm StringManipulation) StringManipulation { return sm.FromRoman() },
+		"Interpolate":      func(sm StringManipulation) StringManipulation { return sm.Interpolate(nil) },
+		"InterpolateWith":  func(sm StringManipulation) StringManipulation { return sm.InterpolateWith(EnvResolver) },
+		"KebabCase":        func(sm StringManipulation) StringManipulation { return sm.KebabCase() },
+		"Mask":             func(sm StringManipulation) StringManipulation { return sm.Mask(1, 1, '*') },
+		"Normalize":        func(sm StringManipulation) StringManipulation { return sm.Normalize(ISBN) },
+		"NumberToWords":    func(sm StringManipulation) StringManipulation { return sm.NumberToWords() },
+		"OrdinalToWords":   func(sm StringManipulation) StringManipulation { return sm.OrdinalToWords() },
+		"Ordinalize":       func(sm StringManipulation) StringManipulation { return sm.Ordinalize() },
+		"PascalCase":       func(sm StringManipulation) StringManipulation { return sm.PascalCase() },
+		"Pluralize":        func(sm StringManipulation) StringManipulation { return sm.Pluralize() },
+		"Redact":           func(sm StringManipulation) StringManipulation { return sm.Redact(nil) },
+		"ReplaceAll":       func(sm StringManipulation) StringManipulation { return sm.ReplaceAll("", "x") },
+		"ReplaceMany":      func(sm StringManipulation) StringManipulation { return sm.ReplaceMany(map[string]string{"": "x"}) },
+		"ReplaceRegex":     func(sm StringManipulation) StringManipulation { return sm.ReplaceRegex("l", "x") },
+		"ReplaceRegexFunc": func(sm StringManipulation) StringManipulation { return sm.ReplaceRegexFunc("l", strings.ToUpper) },
+		"ReplaceWith":      func(sm StringManipulation) StringManipulation { return sm.ReplaceWith(NewReplacer(nil)) },
+		"SentenceCase":     func(sm StringManipulation) StringManipulation { return sm.SentenceCase() },
+		"Singularize":      func(sm StringManipulation) StringManipulation { return sm.Singularize() },
+		"SlugifyWithCount": func(sm StringManipulation) StringManipulation { return sm.SlugifyWithCount(1) },
+		"SnakeCase":        func(sm StringManipulation) StringManipulation { return sm.SnakeCase() },
+		"Substring":        func(sm StringManipulation) StringManipulation { return sm.Substring(0, 1) },
+		"ToRoman":          func(sm StringManipulation) StringManipulation { return sm.ToRoman() },
+		"Trim":             func(sm StringManipulation) StringManipulation { return sm.Trim() },
+		"TruncateWords":    func(sm StringManipulation) StringManipulation { return sm.TruncateWords(1, "...") },
+		"Validate":         func(sm StringManipulation) StringManipulation { return sm.Validate(RuleAlpha) },
+	}
+	for name, chain := range chains {
+		t.Run(name, func(t *testing.T) {
+			sm := chain(failed())
+			val, err := sm.GetE()
+			if val != "" {
+				t.Errorf("Expected empty result but got: %q", val)
+			}
+			var opErr *OpError
+			if !errors.As(err, &opErr) || opErr.Op != "CamelCase" || !errors.Is(err, ErrOddRule) {
+				t.Errorf("Expected the CamelCase error to be kept but got: %v", err)
+			}
+		})
+	}
+
+	accessors := map[string]func(sm StringManipulation) interface{}{
+		"BetweenAll":             func(sm StringManipulation) interface{} { return len(sm.BetweenAll("(", ")")) },
+		"BetweenSpans":           func(sm StringManipulation) interface{} { return len(sm.BetweenSpans("(", ")")) },
+		"Boolean":                func(sm StringManipulation) interface{} { return sm.Boolean() },
+		"BooleanState":           func(sm StringManipulation) interface{} { return sm.BooleanState(nil) },
+		"BooleanWith":            func(sm StringManipulation) interface{} { return sm.BooleanWith(nil) },
+		"ByteSize":               func(sm StringManipulation) interface{} { return sm.ByteSize() },
+		"Contains":               func(sm StringManipulation) interface{} { return sm.Contains("") },
+		"ContainsAll":            func(sm StringManipulation) interface{} { return sm.ContainsAll() },
+		"Duration":               func(sm StringManipulation) interface{} { return sm.Duration() },
+		"First":                  func(sm StringManipulation) interface{} { return sm.First(0) },
+		"FindAll":                func(sm StringManipulation) interface{} { return len(sm.FindAll("l")) },
+		"Float":                  func(sm StringManipulation) interface{} { return sm.Float() },
+		"Get":                    func(sm StringManipulation) interface{} { return sm.Get() },
+		"Glob":                   func(sm StringManipulation) interface{} { return sm.Glob("*") },
+		"Int":                    func(sm StringManipulation) interface{} { return sm.Int() },
+		"IsEmpty":                func(sm StringManipulation) interface{} { return sm.IsEmpty() },
+		"IsAlpha":                func(sm StringManipulation) interface{} { return sm.IsAlpha() },
+		"IsAlphanumeric":         func(sm StringManipulation) interface{} { return sm.IsAlphanumeric() },
+		"IsASCII":                func(sm StringManipulation) interface{} { return sm.IsASCII() },
+		"IsBase64":               func(sm StringManipulation) interface{} { return sm.IsBase64() },
+		"IsEmail":                func(sm StringManipulation) interface{} { return sm.IsEmail() },
+		"IsHex":                  func(sm StringManipulation) interface{} { return sm.IsHex() },
+		"IsHostname":             func(sm StringManipulation) interface{} { return sm.IsHostname() },
+		"IsIPv4":                 func(sm StringManipulation) interface{} { return sm.IsIPv4() },
+		"IsIPv6":                 func(sm StringManipulation) interface{} { return sm.IsIPv6() },
+		"IsJSON":                 func(sm StringManipulation) interface{} { return sm.IsJSON() },
+		"IsNumeric":              func(sm StringManipulation) interface{} { return sm.IsNumeric() },
+		"IsURL":                  func(sm StringManipulation) interface{} { return sm.IsURL() },
+		"IsUUID":                 func(sm StringManipulation) interface{} { return sm.IsUUID(0) },
+		"Last":                   func(sm StringManipulation) interface{} { return sm.Last(0) },
+		"LcFirst":                func(sm StringManipulation) interface{} { return sm.LcFirst() },
+		"Lines":                  func(sm StringManipulation) interface{} { return len(sm.Lines()) },
+		"Match":                  func(sm StringManipulation) interface{} { return sm.Match("l") },
+		"Pad":                    func(sm StringManipulation) interface{} { return sm.Pad(10, "*", Both) },
+		"Percent":                func(sm StringManipulation) interface{} { return sm.Percent() },
+		"Prefix":                 func(sm StringManipulation) interface{} { return sm.Prefix("pre") },
+		"RemoveSpecialCharacter": func(sm StringManipulation) interface{} { return sm.RemoveSpecialCharacter() },
+		"ReplaceFirst":           func(sm StringManipulation) interface{} { return sm.ReplaceFirst("", "x") },
+		"ReplaceLast":            func(sm StringManipulation) interface{} { return sm.ReplaceLast("", "x") },
+		"Reverse":                func(sm StringManipulation) interface{} { return sm.Reverse() },
+		"Shuffle":                func(sm StringManipulation) interface{} { return sm.Shuffle() },
+		"ShuffleWith":            func(sm StringManipulation) interface{} { return sm.ShuffleWith(rand.New(rand.NewSource(1))) },
+		"SplitRegex":             func(sm StringManipulation) interface{} { return len(sm.SplitRegex("l")) },
+		"Suffix":                 func(sm StringManipulation) interface{} { return sm.Suffix("suf") },
+		"Surround":               func(sm StringManipulation) interface{} { return sm.Surround("*") },
+		"Tease":                  func(sm StringManipulation) interface{} { return sm.Tease(0, "...") },
+		"Title":                  func(sm StringManipulation) interface{} { return sm.Title() },
+		"ToLower":                func(sm StringManipulation) interface{} { return sm.ToLower() },
+		"ToUpper":                func(sm StringManipulation) interface{} { return sm.ToUpper() },
+		"UcFirst":                func(sm StringManipulation) interface{} { return sm.UcFirst() },
+		"WordCount":              func(sm StringManipulation) interface{} { return sm.WordCount() },
+		"DamerauLevenshtein":     func(sm StringManipulation) interface{} { return sm.DamerauLevenshtein("x") },
+		"DoubleMetaphone": func(sm StringManipulation) interface{} {
+			primary, alternate := sm.DoubleMetaphone()
+			return primary + alternate
+		},
+		"Metaphone":   func(sm StringManipulation) interface{} { return sm.Metaphone() },
+		"NYSIIS":      func(sm StringManipulation) interface{} { return sm.NYSIIS() },
+		"Soundex":     func(sm StringManipulation) interface{} { return sm.Soundex() },
+		"Hamming":     func(sm StringManipulation) interface{} { return sm.Hamming("x") },
+		"JaroWinkler": func(sm StringManipulation) interface{} { return sm.JaroWinkler("x") },
+		"LCS":         func(sm StringManipulation) interface{} { return sm.LCS("x") },
+		"Levenshtein": func(sm StringManipulation) interface{} { return sm.Levenshtein("x") },
+		"Similarity":  func(sm StringManipulation) interface{} { return sm.Similarity("x") },
+		"Suggest":     func(sm StringManipulation) interface{} { return len(sm.Suggest([]string{"x"}, 5)) },
+		"SuggestFrom": func(sm StringManipulation) interface{} {
+			return len(sm.SuggestFrom(NewSuggester([]string{"x"}, true), 5))
+		},
+	}
+	for name, accessor := range accessors {
+		t.Run(name, func(t *testing.T) {
+			sm := failed()
+			val := accessor(sm)
+			if !reflect.ValueOf(val).IsZero() {
+				t.Errorf("Expected zero value but got: %v", val)
+			}
+			var opErr *OpError
+			if !errors.As(sm.Error(), &opErr) || opErr.Op != "CamelCase" {
+				t.Errorf("Expected the CamelCase error to be kept but got: %v", sm.Error())
+			}
+		})
+	}
+}
+
+// Test GetE and MustGet
+func TestInput_GetEMustGet(t *testing.T) {
+	val, err := New("hello world").SnakeCase().GetE()
+	if val != "hello_world" || err != nil {
+		t.Errorf("Expected: hello_world, <nil> but got: %s, %v", val, err)
+	}
+	if val := New("hello world").KebabCase().MustGet(); val != "hello-world" {
+		t.Errorf("Expected: %s but got: %s", "hello-world", val)
+	}
+
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, ErrOddRule) {
+			t.Errorf("Expected MustGet to panic with ErrOddRule but got: %v", r)
+		}
+	}()
+	New("hello world").KebabCase("%").MustGet()
+}
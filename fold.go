@@ -0,0 +1,118 @@
+package stringy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// diacriticGroups maps the ASCII base of Latin letters to their accented variants
+var diacriticGroups = map[rune]string{
+	'A': "ÀÁÂÃÄÅĀĂĄǍǺȀȂẠẢẤẦẨẪẬẮẰẲẴẶ",
+	'a': "àáâãäåāăąǎǻȁȃạảấầẩẫậắằẳẵặ",
+	'C': "ÇĆĈĊČ",
+	'c': "çćĉċč",
+	'D': "ĎĐ",
+	'd': "ďđ",
+	'E': "ÈÉÊËĒĔĖĘĚȄȆẸẺẼẾỀỂỄỆ",
+	'e': "èéêëēĕėęěȅȇẹẻẽếềểễệ",
+	'G': "ĜĞĠĢǦ",
+	'g': "ĝğġģǧ",
+	'H': "ĤĦ",
+	'h': "ĥħ",
+	'I': "ÌÍÎÏĨĪĬĮİǏȈȊỈỊ",
+	'i': "ìíîïĩīĭįıǐȉȋỉị",
+	'J': "Ĵ",
+	'j': "ĵ",
+	'K': "ĶǨ",
+	'k': "ķǩ",
+	'L': "ĹĻĽĿŁ",
+	'l': "ĺļľŀł",
+	'N': "ÑŃŅŇ",
+	'n': "ñńņňŉ",
+	'O': "ÒÓÔÕÖØŌŎŐǑǾȌȎỌỎỐỒỔỖỘỚỜỞỠỢƠ",
+	'o': "òóôõöøōŏőǒǿȍȏọỏốồổỗộớờởỡợơ",
+	'R': "ŔŖŘȐȒ",
+	'r': "ŕŗřȑȓ",
+	'S': "ŚŜŞŠȘ",
+	's': "śŝşšș",
+	'T': "ŢŤŦȚ",
+	't': "ţťŧț",
+	'U': "ÙÚÛÜŨŪŬŮŰŲǓǕǗǙǛȔȖỤỦỨỪỬỮỰƯ",
+	'u': "ùúûüũūŭůűųǔǖǘǚǜȕȗụủứừửữựư",
+	'W': "ŴẀẂẄ",
+	'w': "ŵẁẃẅ",
+	'Y': "ÝŶŸỲỴỶỸ",
+	'y': "ýÿŷỳỵỷỹ",
+	'Z': "ŹŻŽ",
+	'z': "źżž",
+}
+
+// ligatures maps letters which fold to more than one ASCII letter
+var ligatures = map[rune]string{
+	'Æ': "AE", 'æ': "ae", 'Œ': "OE", 'œ': "oe", 'ß': "ss", 'ẞ': "SS",
+	'Þ': "TH", 'þ': "th", 'Ð': "D", 'ð': "d", 'Ĳ': "IJ", 'ĳ': "ij",
+}
+
+// diacriticMap maps accented Latin letters to their ASCII base, built from diacriticGroups
+var diacriticMap = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for base, variants := range diacriticGroups {
+		for _, r := range variants {
+			m[r] = base
+		}
+	}
+	return m
+}()
+
+/*
+ * foldRune is a helper function to remove the diacritic of a Latin letter.
+ * @param r rune
+ * @return rune the ASCII base letter or r itself if it has no known base
+ */
+func foldRune(r rune) rune {
+	if base, ok := diacriticMap[r]; ok {
+		return base
+	}
+	return r
+}
+
+/*
+ * removeDiacritics is a helper function to strip diacritics from Latin letters and expand
+ * ligatures like "ß" or "æ". Other characters are kept as they are.
+ * @param input string
+ * @return string
+ * Example: "Crème Brûlée" => "Creme Brulee", "Straße" => "Strasse"
+ */
+func removeDiacritics(input string) string {
+	var result strings.Builder
+	result.Grow(len(input))
+	for _, r := range input {
+		if r <= unicode.MaxASCII {
+			result.WriteRune(r)
+		} else if expanded, ok := ligatures[r]; ok {
+			result.WriteString(expanded)
+		} else {
+			result.WriteRune(foldRune(r))
+		}
+	}
+	return result.String()
+}
+
+/*
+ * asciiFold is a helper function to convert input to ASCII by removing diacritics and
+ * dropping every character which has no ASCII equivalent.
+ * @param input string
+ * @return string
+ * Example: "Müller-Łukasz 😀" => "Muller-Lukasz "
+ */
+func asciiFold(input string) string {
+	folded := removeDiacritics(input)
+	var result strings.Builder
+	result.Grow(len(folded))
+	for _, r := range folded {
+		if r <= unicode.MaxASCII {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
@@ -0,0 +1,282 @@
+package stringy
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// globSeparators are the runes which * and ? do not match, ** matches them as well
+const globSeparators = "./"
+
+var (
+	errGlobClass  = errors.New("missing closing ] in glob pattern")
+	errGlobEscape = errors.New("trailing backslash in glob pattern")
+)
+
+/*
+ * globToRegexp is a helper function to translate a glob pattern into an unanchored regular
+ * expression. * and ? do not match the separators "." and "/", ** matches everything and
+ * a "**" between separators also matches no segment at all, e.g. "a.**.b" matches "a.b".
+ * @param pattern string
+ * @return string
+ * @return error *PatternError if the pattern is not a valid glob pattern
+ */
+func globToRegexp(pattern string) (string, error) {
+	var sb strings.Builder
+	afterSeparator := true
+	for pos := 0; pos < len(pattern); {
+		r, size := utf8.DecodeRuneInString(pattern[pos:])
+		pos += size
+		separator := false
+		switch r {
+		case '*':
+			if pos < len(pattern) && pattern[pos] == '*' {
+				for pos < len(pattern) && pattern[pos] == '*' {
+					pos++
+				}
+				if afterSeparator && pos < len(pattern) && strings.IndexByte(globSeparators, pattern[pos]) >= 0 {
+					// "**/" matches any number of segments including none
+					sb.WriteString("(?:.*" + regexp.QuoteMeta(pattern[pos:pos+1]) + ")?")
+					pos++
+					separator = true
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^" + regexp.QuoteMeta(globSeparators) + "]*")
+			}
+		case '?':
+			sb.WriteString("[^" + regexp.QuoteMeta(globSeparators) + "]")
+		case '[':
+			class, next, err := globClass(pattern, pos)
+			if err != nil {
+				return "", &PatternError{Pattern: pattern, Err: err}
+			}
+			sb.WriteString(class)
+			pos = next
+		case '\\':
+			if pos == len(pattern) {
+				return "", &PatternError{Pattern: pattern, Err: errGlobEscape}
+			}
+			r, size = utf8.DecodeRuneInString(pattern[pos:])
+			pos += size
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			separator = strings.ContainsRune(globSeparators, r)
+		}
+		afterSeparator = separator
+	}
+	return sb.String(), nil
+}
+
+/*
+ * globClass is a helper function to translate the character class starting at byte offset
+ * pos, right after its "[", into a regular expression class. "[!" and "[^" negate the class,
+ * a "]" right after the opening bracket is a literal.
+ * @param pattern string
+ * @param pos int byte offset
+ * @return string
+ * @return int byte offset after the closing "]"
+ * @return error if the class is not closed
+ */
+func globClass(pattern string, pos int) (string, int, error) {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	if pos < len(pattern) && (pattern[pos] == '!' || pattern[pos] == '^') {
+		sb.WriteByte('^')
+		pos++
+	}
+	for first := true; pos < len(pattern); first = false {
+		r, size := utf8.DecodeRuneInString(pattern[pos:])
+		pos += size
+		switch {
+		case r == ']' && !first:
+			sb.WriteByte(']')
+			return sb.String(), pos, nil
+		case r == '-' && !first && pos < len(pattern) && pattern[pos] != ']':
+			sb.WriteByte('-')
+			continue
+		case r == '\\':
+			if pos == len(pattern) {
+				return "", 0, errGlobEscape
+			}
+			r, size = utf8.DecodeRuneInString(pattern[pos:])
+			pos += size
+		}
+		if r < utf8.RuneSelf && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return "", 0, errGlobClass
+}
+
+/*
+ * anchorGlob is a helper function to make a translated glob pattern match whole values only.
+ * @param expr string
+ * @param ignoreCase bool
+ * @return string
+ */
+func anchorGlob(expr string, ignoreCase bool) string {
+	if ignoreCase {
+		return "(?i)^(?:" + expr + ")$"
+	}
+	return "^(?:" + expr + ")$"
+}
+
+/*
+ * Glob reports whether the whole value matches the wildcard pattern. * matches any run of
+ * characters except the separators "." and "/", ? matches one such character, [a-z] and
+ * [!a-z] match one character of or not of a class and ** matches anything including
+ * separators, between two separators also nothing, so "a.**.b" matches "a.b" and "a.x.y.b".
+ * A backslash escapes the next character.
+ * Matching is case sensitive by default, pass CaseInsensitive to ignore case.
+ * An invalid pattern records an error which can be read with Error.
+ * @param pattern string
+ * @param opts ...MatchOption
+ * @return bool
+ * Example: "user.42.admin" => Glob("user.*.admin") => true
+ */
+func (i *input) Glob(pattern string, opts ...MatchOption) bool {
+	if i.err != nil {
+		return false
+	}
+
+	input := getInput(*i)
+	expr, err := globToRegexp(pattern)
+	if err != nil {
+		i.err = newOpError("Glob", input, pattern, err)
+		return false
+	}
+	re, err := patternCache.compile(anchorGlob(expr, newMatchConfig(false, opts).ignoreCase))
+	if err != nil {
+		// report the glob pattern instead of the translated expression, e.g. for "[z-a]"
+		if patternErr, ok := err.(*PatternError); ok {
+			err = &PatternError{Pattern: pattern, Err: patternErr.Err}
+		}
+		i.err = newOpError("Glob", input, pattern, err)
+		return false
+	}
+	return re.MatchString(input)
+}
+
+/*
+ * GlobSet matches a value against many glob patterns at once, e.g. the routing rules or
+ * feature flag targets of a service. Patterns without wildcards are looked up in a map and
+ * the others are combined into a single regular expression, so matching does not scan the
+ * patterns one by one. A GlobSet is immutable and safe for concurrent use.
+ */
+type GlobSet struct {
+	patterns   []string
+	ignoreCase bool
+	literals   map[string][]int // pattern indexes by the literal they match
+	globs      []*regexp.Regexp // compiled wildcard patterns
+	globIndex  []int            // pattern index of every entry of globs
+	anyGlob    *regexp.Regexp   // union of the wildcard patterns, nil if there are none
+}
+
+/*
+ * NewGlobSet compiles patterns into a GlobSet, see Glob for the pattern syntax.
+ * Matching is case sensitive by default, pass CaseInsensitive to ignore case.
+ * @param patterns []string
+ * @param opts ...MatchOption
+ * @return *GlobSet
+ * @return error *PatternError for the first invalid pattern
+ */
+func NewGlobSet(patterns []string, opts ...MatchOption) (*GlobSet, error) {
+	set := &GlobSet{
+		patterns:   append([]string(nil), patterns...),
+		ignoreCase: newMatchConfig(false, opts).ignoreCase,
+		literals:   map[string][]int{},
+	}
+	union := []string{}
+	for idx, pattern := range patterns {
+		if !strings.ContainsAny(pattern, `*?[\`) {
+			key := set.key(pattern)
+			set.literals[key] = append(set.literals[key], idx)
+			continue
+		}
+		expr, err := globToRegexp(pattern)
+		if err != nil {
+			return nil, err
+		}
+		// not cached, a large set would push the patterns of the chain methods out of the cache
+		re, err := regexp.Compile(anchorGlob(expr, set.ignoreCase))
+		if err != nil {
+			return nil, &PatternError{Pattern: pattern, Err: err}
+		}
+		set.globs = append(set.globs, re)
+		set.globIndex = append(set.globIndex, idx)
+		union = append(union, "(?:"+expr+")")
+	}
+	if len(union) > 0 {
+		re, err := regexp.Compile(anchorGlob(strings.Join(union, "|"), set.ignoreCase))
+		if err != nil {
+			return nil, &PatternError{Pattern: strings.Join(patterns, " "), Err: err}
+		}
+		set.anyGlob = re
+	}
+	return set, nil
+}
+
+/*
+ * key is a helper function to build the map key of a literal pattern or value, folding
+ * its case if the set ignores case.
+ * @param val string
+ * @return string
+ */
+func (set *GlobSet) key(val string) string {
+	if !set.ignoreCase {
+		return val
+	}
+	return strings.Map(caseFold, val)
+}
+
+/*
+ * Match reports whether val matches any pattern of the set.
+ * @param val string
+ * @return bool
+ * Example: NewGlobSet([]string{"user.*.admin", "*-prod-??"}).Match("api-prod-eu") => true
+ */
+func (set *GlobSet) Match(val string) bool {
+	if _, ok := set.literals[set.key(val)]; ok {
+		return true
+	}
+	return set.anyGlob != nil && set.anyGlob.MatchString(val)
+}
+
+/*
+ * Matches returns the patterns of the set matched by val, in the order they were passed to NewGlobSet.
+ * @param val string
+ * @return []string
+ * Example: NewGlobSet([]string{"user.*.admin", "user.**", "guest.*"}).Matches("user.42.admin") => []string{"user.*.admin", "user.**"}
+ */
+func (set *GlobSet) Matches(val string) []string {
+	matched := make([]bool, len(set.patterns))
+	found := false
+	for _, idx := range set.literals[set.key(val)] {
+		matched[idx], found = true, true
+	}
+	if set.anyGlob != nil && set.anyGlob.MatchString(val) {
+		for n, re := range set.globs {
+			if re.MatchString(val) {
+				matched[set.globIndex[n]], found = true, true
+			}
+		}
+	}
+
+	patterns := []string{}
+	if !found {
+		return patterns
+	}
+	for idx, ok := range matched {
+		if ok {
+			patterns = append(patterns, set.patterns[idx])
+		}
+	}
+	return patterns
+}
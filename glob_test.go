@@ -0,0 +1,136 @@
+package stringy
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// Test Glob with *, ?, classes, ** and escapes
+func TestInput_Glob(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		pattern  string
+		opts     []MatchOption
+		expected bool
+	}{
+		{"Star", "user.42.admin", "user.*.admin", nil, true},
+		{"StarEmpty", "user..admin", "user.*.admin", nil, true},
+		{"StarSeparator", "user.a.b.admin", "user.*.admin", nil, false},
+		{"Question", "api-prod-eu", "*-prod-??", nil, true},
+		{"QuestionLength", "api-prod-eu1", "*-prod-??", nil, false},
+		{"QuestionUnicode", "größe", "gr??e", nil, true},
+		{"Class", "node7", "node[0-9]", nil, true},
+		{"ClassMiss", "nodeX", "node[0-9]", nil, false},
+		{"ClassNegated", "nodeX", "node[!0-9]", nil, true},
+		{"ClassCaret", "node7", "node[^0-9]", nil, false},
+		{"ClassBracket", "a]", "a[]]", nil, true},
+		{"ClassDash", "a-", "a[x-]", nil, true},
+		{"ClassPunctuation", "a.", "a[.,]", nil, true},
+		{"DoubleStar", "user.a.b.admin", "user.**.admin", nil, true},
+		{"DoubleStarNone", "user.admin", "user.**.admin", nil, true},
+		{"DoubleStarNoPartial", "user.xadmin", "user.**.admin", nil, false},
+		{"DoubleStarLeading", "admin", "**/admin", nil, true},
+		{"DoubleStarPath", "src/a/b/main.go", "src/**/*.go", nil, true},
+		{"DoubleStarTrailing", "logs/2024/01/app.log", "logs/**", nil, true},
+		{"DoubleStarInWord", "a.b.c", "a**c", nil, true},
+		{"Escape", "a*b", `a\*b`, nil, true},
+		{"EscapeMiss", "axb", `a\*b`, nil, false},
+		{"Literal", "feature.new-ui", "feature.new-ui", nil, true},
+		{"RegexMeta", "a+b(c)", "a+b(c)", nil, true},
+		{"CaseSensitive", "User.42.Admin", "user.*.admin", nil, false},
+		{"CaseInsensitive", "User.42.Admin", "user.*.admin", []MatchOption{CaseInsensitive}, true},
+		{"CaseInsensitiveClass", "NODE7", "node[0-9]", []MatchOption{CaseInsensitive}, true},
+		{"Empty", "", "", nil, true},
+		{"EmptyStar", "", "*", nil, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sm := New(tc.input)
+			if val := sm.Glob(tc.pattern, tc.opts...); val != tc.expected {
+				t.Errorf("Expected: %v but got: %v", tc.expected, val)
+			}
+			if err := sm.Error(); err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+// Test invalid glob patterns are surfaced through Error
+func TestInput_GlobInvalidPattern(t *testing.T) {
+	for _, pattern := range []string{"node[0-9", `trailing\`, `[a\`, "node[z-a]"} {
+		sm := New("node1")
+		if sm.Glob(pattern) {
+			t.Errorf("Expected %s not to match", pattern)
+		}
+		var patternErr *PatternError
+		if !errors.As(sm.Error(), &patternErr) || !errors.Is(sm.Error(), ErrInvalidPattern) || patternErr.Pattern != pattern {
+			t.Errorf("Expected a PatternError for %s but got: %v", pattern, sm.Error())
+		}
+	}
+}
+
+// Test GlobSet against many patterns and concurrent use
+func TestGlobSet(t *testing.T) {
+	set, err := NewGlobSet([]string{"user.*.admin", "user.**", "*-prod-??", "guest", "feature.[a-m]*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		input    string
+		expected []string
+	}{
+		{"user.42.admin", []string{"user.*.admin", "user.**"}},
+		{"api-prod-eu", []string{"*-prod-??"}},
+		{"guest", []string{"guest"}},
+		{"feature.dark-mode", []string{"feature.[a-m]*"}},
+		{"feature.new-ui", []string{}},
+		{"Guest", []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if val := set.Matches(tc.input); !reflect.DeepEqual(val, tc.expected) {
+				t.Errorf("Expected: %q but got: %q", tc.expected, val)
+			}
+			if val := set.Match(tc.input); val != (len(tc.expected) > 0) {
+				t.Errorf("Expected Match: %v but got: %v", len(tc.expected) > 0, val)
+			}
+		})
+	}
+
+	folded, err := NewGlobSet([]string{"guest", "USER.*"}, CaseInsensitive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val := folded.Matches("GUEST"); !reflect.DeepEqual(val, []string{"guest"}) {
+		t.Errorf("Expected a case-insensitive literal match but got: %q", val)
+	}
+	if !folded.Match("user.admin") {
+		t.Errorf("Expected a case-insensitive wildcard match")
+	}
+
+	if _, err := NewGlobSet([]string{"ok", "bad["}); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern but got: %v", err)
+	}
+	var patternErr *PatternError
+	if _, err := NewGlobSet([]string{"ok", "[z-a]"}); !errors.As(err, &patternErr) || patternErr.Pattern != "[z-a]" {
+		t.Errorf("Expected a PatternError for [z-a] but got: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 100; n++ {
+				if !set.Match("user.x.admin") || set.Match("nobody") {
+					t.Errorf("Expected concurrent matches to be stable")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,10 +1,10 @@
 package stringy
 
 import (
-	"errors"
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 var selectCapitalRegexp = regexp.MustCompile(SelectCapital)
@@ -40,7 +40,7 @@ func caseHelper(input string, isCamel bool, rule ...string) ([]string, error) {
 	}
 	input = strings.Join(strings.Fields(strings.TrimSpace(input)), " ")
 	if len(rule) > 0 && len(rule)%2 != 0 {
-		return nil, errors.New(OddError)
+		return nil, ErrOddRule
 	}
 	rule = append(rule, ".", " ", "_", " ", "-", " ")
 
@@ -69,21 +69,35 @@ func caseHelper(input string, isCamel bool, rule ...string) ([]string, error) {
 	return words, nil
 }
 
+/*
+ * assertLive is a helper function to detect use of an input after it was released.
+ * It only does anything when the package is built with the stringydebug tag,
+ * in which case it panics if the input has already been released.
+ * @param i input struct
+ */
+func assertLive(i input) {
+	if debugLifecycle && i.released {
+		panic(UseAfterReleaseError)
+	}
+}
+
 /**
  * getInput is a helper function to get the input string from the input struct.
  * It checks if there is an error in the input struct and returns an empty string if there is.
- * If there is no error, it checks if the Result field is not empty and returns that.
- * If the Result field is empty, it returns the Input field.
+ * If there is no error, it returns the Result field once a chain step has produced one,
+ * even if that result is an empty string, otherwise it returns the Input field.
  * @param i input struct
  * @return string
  */
 func getInput(i input) (input string) {
+	assertLive(i)
+
 	// If there's an error, return an empty string
 	if i.err != nil {
 		return ""
 	}
 
-	if i.Result != "" {
+	if i.hasResult {
 		input = i.Result
 	} else {
 		input = i.Input
@@ -91,28 +105,169 @@ func getInput(i input) (input string) {
 	return
 }
 
+/*
+ * setResult is a helper function to store the result of a chain step in the input struct.
+ * It marks the result as set so that an empty result is passed on to the next step
+ * instead of falling back to the original input.
+ * @param i *input
+ * @param result string
+ */
+func setResult(i *input, result string) {
+	i.Result = result
+	i.hasResult = true
+}
+
 /*
  * replaceStr is a helper function to replace the first or last occurrence of a substring in a string.
  * It takes the input string, the substring to search for, the replacement string,
- * and the type of replacement (first or last).
+ * the type of replacement (first or last) and the match options.
  * @param input string
  * @param search string substring to search for
  * @param replace string replacement string
  * @param types string type of replacement (first or last)
+ * @param cfg matchConfig
  * @return string the modified string
  */
-func replaceStr(input, search, replace, types string) string {
-	lcInput := strings.ToLower(input)
-	lcSearch := strings.ToLower(search)
-	if input == "" || !strings.Contains(lcInput, lcSearch) {
+func replaceStr(input, search, replace, types string, cfg matchConfig) string {
+	if input == "" {
 		return input
 	}
-	var start int
-	if types == "last" {
-		start = strings.LastIndex(lcInput, lcSearch)
+	var start, end int
+	if types == Last {
+		start, end = findLast(input, search, cfg)
 	} else {
-		start = strings.Index(lcInput, lcSearch)
+		start, end = find(input, search, 0, cfg)
+	}
+	if start == -1 {
+		return input
+	}
+	return input[:start] + cfg.replacement(input[start:end], replace) + input[end:]
+}
+
+/*
+ * find is a helper function to find the first match of substr in input at or after byte offset from.
+ * Case-insensitive matching compares runes with simple case folding, so the match may have a
+ * different byte length than substr, e.g. "k" matches the three byte Kelvin sign.
+ * @param input string
+ * @param substr string
+ * @param from int byte offset
+ * @param cfg matchConfig
+ * @return int byte offset of the match, -1 if there is none
+ * @return int byte offset after the match
+ */
+func find(input, substr string, from int, cfg matchConfig) (int, int) {
+	for start := from; start <= len(input); {
+		if end, ok := matchAt(input, substr, start, cfg); ok {
+			return start, end
+		}
+		if start == len(input) {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(input[start:])
+		start += size
 	}
-	end := start + len(search)
-	return input[:start] + replace + input[end:]
+	return -1, -1
+}
+
+/*
+ * findLast is a helper function to find the last match of substr in input, see find.
+ * @param input string
+ * @param substr string
+ * @param cfg matchConfig
+ * @return int byte offset of the match, -1 if there is none
+ * @return int byte offset after the match
+ */
+func findLast(input, substr string, cfg matchConfig) (int, int) {
+	for start := len(input); start >= 0; {
+		if end, ok := matchAt(input, substr, start, cfg); ok {
+			return start, end
+		}
+		if start == 0 {
+			break
+		}
+		_, size := utf8.DecodeLastRuneInString(input[:start])
+		start -= size
+	}
+	return -1, -1
+}
+
+/*
+ * matchAt is a helper function to check if substr matches input at byte offset start.
+ * @param input string
+ * @param substr string
+ * @param start int byte offset
+ * @param cfg matchConfig
+ * @return int byte offset after the match
+ * @return bool
+ */
+func matchAt(input, substr string, start int, cfg matchConfig) (int, bool) {
+	end := start
+	if cfg.ignoreCase {
+		for _, want := range substr {
+			if end >= len(input) {
+				return 0, false
+			}
+			r, size := utf8.DecodeRuneInString(input[end:])
+			if r != want && caseFold(r) != caseFold(want) {
+				return 0, false
+			}
+			end += size
+		}
+	} else {
+		if !strings.HasPrefix(input[start:], substr) {
+			return 0, false
+		}
+		end += len(substr)
+	}
+	if cfg.wholeWord && !standsAlone(input, start, end) {
+		return 0, false
+	}
+	return end, true
+}
+
+/*
+ * graphemes is a helper function to split input into user perceived characters. It keeps
+ * combining marks, variation selectors, emoji modifiers and tags with their base, joins
+ * ZWJ sequences, pairs regional indicators into flags and keeps CRLF together. It is a
+ * close approximation of the Unicode extended grapheme cluster rules.
+ * @param input string
+ * @return []string
+ * Example: graphemes("e\u0301🇩🇪") => ["é", "🇩🇪"]
+ */
+func graphemes(input string) []string {
+	clusters := make([]string, 0, len(input))
+	start, prev, regional := 0, rune(-1), 0
+	for idx, r := range input {
+		join := idx == 0 || (prev == '\r' && r == '\n') || prev == '\u200d' || isGraphemeExtend(r) ||
+			(isRegionalIndicator(r) && isRegionalIndicator(prev) && regional%2 == 1)
+		if !join {
+			clusters = append(clusters, input[start:idx])
+			start = idx
+		}
+		if isRegionalIndicator(r) {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = r
+	}
+	if start < len(input) {
+		clusters = append(clusters, input[start:])
+	}
+	return clusters
+}
+
+// isGraphemeExtend is a helper function to check if r extends the preceding character
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == '\u200d' ||
+		(r >= 0xFE00 && r <= 0xFE0F) || // variation selectors
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // emoji skin tone modifiers
+		(r >= 0xE0020 && r <= 0xE007F) || // tags
+		(r >= 0xE0100 && r <= 0xE01EF) // variation selectors supplement
+}
+
+// isRegionalIndicator is a helper function to check if r is one of the letters forming flags
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
 }
@@ -0,0 +1,282 @@
+package stringy
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NumberFormat describes how numbers are grouped and separated for a locale
+type NumberFormat struct {
+	Thousands string // separator between digit groups
+	Decimal   string // separator between integer and fraction
+	Grouping  []int  // group sizes from the right, the last size repeats
+}
+
+// Number formats of common locales
+var (
+	NumberFormatEN = NumberFormat{Thousands: ",", Decimal: ".", Grouping: []int{3}}
+	NumberFormatDE = NumberFormat{Thousands: ".", Decimal: ",", Grouping: []int{3}}
+	NumberFormatFR = NumberFormat{Thousands: "\u202f", Decimal: ",", Grouping: []int{3}}
+	NumberFormatCH = NumberFormat{Thousands: "'", Decimal: ".", Grouping: []int{3}}
+	NumberFormatIN = NumberFormat{Thousands: ",", Decimal: ".", Grouping: []int{3, 2}}
+)
+
+// Clock provides the current time, it allows injecting a fixed time into RelativeTime
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface
+type ClockFunc func() time.Time
+
+// Now returns f()
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// durationUnit is a unit used by HumanizeDuration and RelativeTime
+type durationUnit struct {
+	name string
+	size time.Duration
+}
+
+// durationUnits are the units used by HumanizeDuration, from largest to smallest
+var durationUnits = []durationUnit{
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+	{"millisecond", time.Millisecond},
+	{"microsecond", time.Microsecond},
+	{"nanosecond", time.Nanosecond},
+}
+
+// relativeUnits are the units used by RelativeTime, from largest to smallest
+var relativeUnits = []durationUnit{
+	{"year", 365 * 24 * time.Hour},
+	{"month", 30 * 24 * time.Hour},
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+}
+
+/*
+ * plural is a helper function to format a count with a singular or plural unit name.
+ * @param count int64
+ * @param unit string singular unit name
+ * @return string e.g. "1 hour", "3 hours"
+ */
+func plural(count int64, unit string) string {
+	if count == 1 {
+		return "1 " + unit
+	}
+	return strconv.FormatInt(count, 10) + " " + unit + "s"
+}
+
+/*
+ * humanizeBytes is a helper function to format size with the given unit base and names.
+ * @param size int64
+ * @param base float64 1000 or 1024
+ * @param units []string unit names starting with the one for base
+ * @return string
+ */
+func humanizeBytes(size int64, base float64, units []string) string {
+	sign := ""
+	value := float64(size)
+	if size < 0 {
+		sign = "-"
+		value = -value
+	}
+	if value < base {
+		return sign + strconv.FormatFloat(value, 'f', -1, 64) + " B"
+	}
+	unit := -1
+	for value >= base && unit < len(units)-1 {
+		value /= base
+		unit++
+	}
+	// avoid "1024.0 KiB" when rounding pushes the value to the next unit
+	if math.Round(value*10)/10 >= base && unit < len(units)-1 {
+		value /= base
+		unit++
+	}
+	formatted := strings.TrimSuffix(strconv.FormatFloat(value, 'f', 1, 64), ".0")
+	return sign + formatted + " " + units[unit]
+}
+
+/*
+ * HumanizeBytes formats a number of bytes using IEC units (powers of 1024) with one decimal.
+ * @param size int64 number of bytes
+ * @return string
+ * Example: HumanizeBytes(1536) => "1.5 KiB", HumanizeBytes(512) => "512 B"
+ */
+func HumanizeBytes(size int64) string {
+	return humanizeBytes(size, 1024, []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"})
+}
+
+/*
+ * HumanizeBytesSI formats a number of bytes using SI units (powers of 1000) with one decimal.
+ * @param size int64 number of bytes
+ * @return string
+ * Example: HumanizeBytesSI(1500) => "1.5 kB"
+ */
+func HumanizeBytesSI(size int64) string {
+	return humanizeBytes(size, 1000, []string{"kB", "MB", "GB", "TB", "PB", "EB"})
+}
+
+/*
+ * groupDigits is a helper function to insert the thousands separator of format into digits.
+ * @param digits string integer digits without sign
+ * @param format NumberFormat
+ * @return string
+ */
+func groupDigits(digits string, format NumberFormat) string {
+	if len(format.Grouping) == 0 || format.Thousands == "" {
+		return digits
+	}
+	var groups []string
+	end := len(digits)
+	for idx := 0; end > 0; idx++ {
+		size := format.Grouping[len(format.Grouping)-1]
+		if idx < len(format.Grouping) {
+			size = format.Grouping[idx]
+		}
+		if size <= 0 || size >= end {
+			groups = append(groups, digits[:end])
+			break
+		}
+		groups = append(groups, digits[end-size:end])
+		end -= size
+	}
+	var result strings.Builder
+	for idx := len(groups) - 1; idx >= 0; idx-- {
+		result.WriteString(groups[idx])
+		if idx > 0 {
+			result.WriteString(format.Thousands)
+		}
+	}
+	return result.String()
+}
+
+/*
+ * FormatInt formats an integer with the digit grouping of format.
+ * @param n int64
+ * @param format NumberFormat
+ * @return string
+ * Example: FormatInt(1234567, NumberFormatEN) => "1,234,567"
+ * FormatInt(1234567, NumberFormatIN) => "12,34,567"
+ */
+func FormatInt(n int64, format NumberFormat) string {
+	digits := strconv.FormatInt(n, 10)
+	if n < 0 {
+		return "-" + groupDigits(digits[1:], format)
+	}
+	return groupDigits(digits, format)
+}
+
+/*
+ * FormatNumber formats a floating point number rounded to decimals with the separators of format.
+ * @param n float64
+ * @param decimals int number of digits after the decimal separator
+ * @param format NumberFormat
+ * @return string
+ * Example: FormatNumber(1234567.891, 2, NumberFormatDE) => "1.234.567,89"
+ */
+func FormatNumber(n float64, decimals int, format NumberFormat) string {
+	if decimals < 0 {
+		decimals = 0
+	}
+	formatted := strconv.FormatFloat(math.Abs(n), 'f', decimals, 64)
+	integer, fraction := formatted, ""
+	if dot := strings.IndexByte(formatted, '.'); dot != -1 {
+		integer, fraction = formatted[:dot], formatted[dot+1:]
+	}
+	result := groupDigits(integer, format)
+	if fraction != "" {
+		result += format.Decimal + fraction
+	}
+	if n < 0 && strings.Trim(formatted, "0.") != "" {
+		result = "-" + result
+	}
+	return result
+}
+
+/*
+ * absDuration is a helper function to return the magnitude of d. The minimum duration has no
+ * positive counterpart and is reported as the maximum one, 1ns less.
+ * @param d time.Duration
+ * @return time.Duration
+ */
+func absDuration(d time.Duration) time.Duration {
+	if d == math.MinInt64 {
+		return math.MaxInt64
+	}
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+/*
+ * HumanizeDuration formats a duration using its two largest non zero units.
+ * @param d time.Duration
+ * @return string
+ * Example: HumanizeDuration(90*time.Minute) => "1 hour 30 minutes"
+ * HumanizeDuration(250*time.Millisecond) => "250 milliseconds"
+ * HumanizeDuration(1500*time.Nanosecond) => "1 microsecond 500 nanoseconds"
+ */
+func HumanizeDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = absDuration(d)
+	}
+	var parts []string
+	for _, unit := range durationUnits {
+		if count := int64(d / unit.size); count > 0 {
+			parts = append(parts, plural(count, unit.name))
+			d -= time.Duration(count) * unit.size
+		} else if len(parts) > 0 {
+			// only adjacent units are combined, "1 day 5 seconds" is reported as "1 day"
+			break
+		}
+		if len(parts) == 2 {
+			break
+		}
+	}
+	if len(parts) == 0 {
+		return "0 seconds"
+	}
+	return sign + strings.Join(parts, " ")
+}
+
+/*
+ * RelativeTime formats t relative to the current time of clock, like "3 hours ago" or "in 2 days".
+ * @param t time.Time
+ * @param clock Clock, nil uses the system clock
+ * @return string
+ * Note: Differences below one second are reported as "just now".
+ * Example: RelativeTime(now.Add(-3*time.Hour), clock) => "3 hours ago"
+ */
+func RelativeTime(t time.Time, clock Clock) string {
+	now := time.Now()
+	if clock != nil {
+		now = clock.Now()
+	}
+	diff := t.Sub(now)
+	future := diff > 0
+	diff = absDuration(diff)
+	for _, unit := range relativeUnits {
+		if count := int64(diff / unit.size); count > 0 {
+			if future {
+				return "in " + plural(count, unit.name)
+			}
+			return plural(count, unit.name) + " ago"
+		}
+	}
+	return "just now"
+}
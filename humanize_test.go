@@ -0,0 +1,132 @@
+package stringy
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// Test HumanizeBytes and HumanizeBytesSI
+func TestHumanizeBytes(t *testing.T) {
+	testCases := []struct {
+		size int64
+		iec  string
+		si   string
+	}{
+		{0, "0 B", "0 B"},
+		{512, "512 B", "512 B"},
+		{1000, "1000 B", "1 kB"},
+		{1024, "1 KiB", "1 kB"},
+		{1536, "1.5 KiB", "1.5 kB"},
+		{1048575, "1 MiB", "1 MB"},
+		{10 << 20, "10 MiB", "10.5 MB"},
+		{-2048, "-2 KiB", "-2 kB"},
+	}
+	for _, tc := range testCases {
+		if val := HumanizeBytes(tc.size); val != tc.iec {
+			t.Errorf("HumanizeBytes(%d) - Expected: %s but got: %s", tc.size, tc.iec, val)
+		}
+		if val := HumanizeBytesSI(tc.size); val != tc.si {
+			t.Errorf("HumanizeBytesSI(%d) - Expected: %s but got: %s", tc.size, tc.si, val)
+		}
+	}
+}
+
+// Test FormatInt and FormatNumber with locale grouping
+func TestFormatNumber(t *testing.T) {
+	intCases := []struct {
+		n        int64
+		format   NumberFormat
+		expected string
+	}{
+		{0, NumberFormatEN, "0"},
+		{999, NumberFormatEN, "999"},
+		{1234567, NumberFormatEN, "1,234,567"},
+		{-1234567, NumberFormatDE, "-1.234.567"},
+		{1234567, NumberFormatCH, "1'234'567"},
+		{123456789, NumberFormatIN, "12,34,56,789"},
+		{1000, NumberFormatIN, "1,000"},
+	}
+	for _, tc := range intCases {
+		if val := FormatInt(tc.n, tc.format); val != tc.expected {
+			t.Errorf("FormatInt(%d) - Expected: %s but got: %s", tc.n, tc.expected, val)
+		}
+	}
+
+	floatCases := []struct {
+		n        float64
+		decimals int
+		format   NumberFormat
+		expected string
+	}{
+		{1234567.891, 2, NumberFormatEN, "1,234,567.89"},
+		{1234567.891, 2, NumberFormatDE, "1.234.567,89"},
+		{1234.6, 0, NumberFormatFR, "1\u202f235"},
+		{-0.001, 2, NumberFormatEN, "0.00"},
+		{-1234.5, 1, NumberFormatEN, "-1,234.5"},
+	}
+	for _, tc := range floatCases {
+		if val := FormatNumber(tc.n, tc.decimals, tc.format); val != tc.expected {
+			t.Errorf("FormatNumber(%v) - Expected: %s but got: %s", tc.n, tc.expected, val)
+		}
+	}
+}
+
+// Test HumanizeDuration
+func TestHumanizeDuration(t *testing.T) {
+	testCases := []struct {
+		d        time.Duration
+		expected string
+	}{
+		{0, "0 seconds"},
+		{250 * time.Millisecond, "250 milliseconds"},
+		{time.Millisecond + 5*time.Microsecond, "1 millisecond 5 microseconds"},
+		{500 * time.Microsecond, "500 microseconds"},
+		{1500 * time.Nanosecond, "1 microsecond 500 nanoseconds"},
+		{time.Nanosecond, "1 nanosecond"},
+		{-999 * time.Nanosecond, "-999 nanoseconds"},
+		{time.Second, "1 second"},
+		{90 * time.Minute, "1 hour 30 minutes"},
+		{26*time.Hour + 5*time.Minute, "1 day 2 hours"},
+		{24*time.Hour + 5*time.Second, "1 day"},
+		{-45 * time.Second, "-45 seconds"},
+		{math.MaxInt64, "106751 days 23 hours"},
+		{math.MinInt64, "-106751 days 23 hours"},
+	}
+	for _, tc := range testCases {
+		if val := HumanizeDuration(tc.d); val != tc.expected {
+			t.Errorf("HumanizeDuration(%v) - Expected: %s but got: %s", tc.d, tc.expected, val)
+		}
+	}
+}
+
+// Test RelativeTime with an injected clock
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+
+	testCases := []struct {
+		t        time.Time
+		expected string
+	}{
+		{now, "just now"},
+		{now.Add(-500 * time.Millisecond), "just now"},
+		{now.Add(-time.Second), "1 second ago"},
+		{now.Add(-3 * time.Hour), "3 hours ago"},
+		{now.Add(48 * time.Hour), "in 2 days"},
+		{now.Add(time.Minute), "in 1 minute"},
+		{now.Add(-14 * 24 * time.Hour), "2 weeks ago"},
+		{now.Add(-65 * 24 * time.Hour), "2 months ago"},
+		{now.Add(400 * 24 * time.Hour), "in 1 year"},
+		{time.Time{}, "292 years ago"},
+	}
+	for _, tc := range testCases {
+		if val := RelativeTime(tc.t, clock); val != tc.expected {
+			t.Errorf("RelativeTime(%v) - Expected: %s but got: %s", tc.t, tc.expected, val)
+		}
+	}
+
+	if val := RelativeTime(time.Now().Add(-2*time.Hour), nil); val != "2 hours ago" {
+		t.Errorf("RelativeTime with system clock - Expected: 2 hours ago but got: %s", val)
+	}
+}
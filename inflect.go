@@ -0,0 +1,412 @@
+package stringy
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// inflectionRule replaces words matching pattern with replacement
+type inflectionRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+/*
+ * Inflector converts words between their singular and plural forms.
+ * Rules are applied to the last word of the input so compound names like "BlogPost"
+ * or "user_category" are inflected too, and the casing of that word is preserved.
+ * Rules registered later take precedence over earlier ones. An Inflector is safe for
+ * concurrent use, including registering rules while it is in use.
+ */
+type Inflector struct {
+	mu           sync.RWMutex
+	plurals      []inflectionRule
+	singulars    []inflectionRule
+	irregulars   map[string]string // singular to plural
+	irregularsR  map[string]string // plural to singular
+	uncountables map[string]struct{}
+}
+
+// DefaultInflector holds the default English ruleset and is used by the Pluralize
+// and Singularize chain methods. Rules can be registered on it at any time.
+var DefaultInflector = NewEnglishInflector()
+
+/*
+ * NewInflector creates an Inflector without any rules.
+ * @return *Inflector
+ */
+func NewInflector() *Inflector {
+	return &Inflector{
+		irregulars:   map[string]string{},
+		irregularsR:  map[string]string{},
+		uncountables: map[string]struct{}{},
+	}
+}
+
+/*
+ * NewEnglishInflector creates an Inflector with the default English ruleset,
+ * including common irregular and uncountable words.
+ * @return *Inflector
+ */
+func NewEnglishInflector() *Inflector {
+	in := NewInflector()
+	for _, rule := range [][2]string{
+		{`$`, "s"},
+		{`s$`, "s"},
+		{`^(ax|test)is$`, "${1}es"},
+		{`(octop|vir)us$`, "${1}i"},
+		{`(octop|vir)i$`, "${1}i"},
+		{`(alias|status)$`, "${1}es"},
+		{`(bu)s$`, "${1}ses"},
+		{`(buffal|tomat|her|potat|ech|vet)o$`, "${1}oes"},
+		{`([ti])um$`, "${1}a"},
+		{`([ti])a$`, "${1}a"},
+		{`sis$`, "ses"},
+		{`(?:([^f])fe|([lr])f)$`, "${1}${2}ves"},
+		{`(hive)$`, "${1}s"},
+		{`([^aeiouy]|qu)y$`, "${1}ies"},
+		{`(x|ch|ss|sh)$`, "${1}es"},
+		{`(matr|vert|ind)(?:ix|ex)$`, "${1}ices"},
+		{`^(m|l)ouse$`, "${1}ice"},
+		{`^(m|l)ice$`, "${1}ice"},
+		{`^(ox)$`, "${1}en"},
+		{`^(oxen)$`, "${1}"},
+		{`(quiz)$`, "${1}zes"},
+	} {
+		in.mustAddRule(&in.plurals, rule[0], rule[1])
+	}
+	for _, rule := range [][2]string{
+		{`s$`, ""},
+		{`(ss)$`, "${1}"},
+		{`(n)ews$`, "${1}ews"},
+		{`([ti])a$`, "${1}um"},
+		{`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, "${1}sis"},
+		{`(^analy)(sis|ses)$`, "${1}sis"},
+		{`([^f])ves$`, "${1}fe"},
+		{`(hive)s$`, "${1}"},
+		{`(tive)s$`, "${1}"},
+		{`([lr])ves$`, "${1}f"},
+		{`([^aeiouy]|qu)ies$`, "${1}y"},
+		{`(s)eries$`, "${1}eries"},
+		{`(m)ovies$`, "${1}ovie"},
+		{`(x|ch|ss|sh)es$`, "${1}"},
+		{`^(m|l)ice$`, "${1}ouse"},
+		{`(bus)(es)?$`, "${1}"},
+		{`(o)es$`, "${1}"},
+		{`(shoe)s$`, "${1}"},
+		{`(cris|test)(is|es)$`, "${1}is"},
+		{`^(a)x[ie]s$`, "${1}xis"},
+		{`(octop|vir)(us|i)$`, "${1}us"},
+		{`(alias|status)(es)?$`, "${1}"},
+		{`^(ox)en`, "${1}"},
+		{`(vert|ind)ices$`, "${1}ex"},
+		{`(matr)ices$`, "${1}ix"},
+		{`(quiz)zes$`, "${1}"},
+		{`(database)s$`, "${1}"},
+	} {
+		in.mustAddRule(&in.singulars, rule[0], rule[1])
+	}
+	for _, pair := range [][2]string{
+		{"person", "people"},
+		{"man", "men"},
+		{"woman", "women"},
+		{"child", "children"},
+		{"foot", "feet"},
+		{"tooth", "teeth"},
+		{"goose", "geese"},
+		{"sex", "sexes"},
+		{"move", "moves"},
+		{"zombie", "zombies"},
+		{"cookie", "cookies"},
+	} {
+		in.AddIrregular(pair[0], pair[1])
+	}
+	in.AddUncountable("equipment", "information", "rice", "money", "species", "series",
+		"fish", "sheep", "deer", "jeans", "police", "news", "metadata", "feedback", "software")
+	return in
+}
+
+/*
+ * mustAddRule is a helper function to register a built-in rule, it panics on invalid patterns.
+ * @param rules *[]inflectionRule
+ * @param pattern string
+ * @param replacement string
+ */
+func (in *Inflector) mustAddRule(rules *[]inflectionRule, pattern, replacement string) {
+	*rules = append(*rules, inflectionRule{regexp.MustCompile("(?i)" + pattern), replacement})
+}
+
+/*
+ * addRule is a helper function to register a user rule.
+ * @param rules *[]inflectionRule
+ * @param pattern string
+ * @param replacement string
+ * @return error if the pattern is not a valid regular expression
+ */
+func (in *Inflector) addRule(rules *[]inflectionRule, pattern, replacement string) error {
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return err
+	}
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	*rules = append(*rules, inflectionRule{re, replacement})
+	return nil
+}
+
+/*
+ * AddPlural registers a rule turning singular words matching pattern into their plural.
+ * The pattern is a case-insensitive regular expression and replacement may reference
+ * its groups like regexp.ReplaceAllString, e.g. AddPlural(`(cact)us$`, "${1}i").
+ * @param pattern string
+ * @param replacement string
+ * @return error if the pattern is not a valid regular expression
+ */
+func (in *Inflector) AddPlural(pattern, replacement string) error {
+	return in.addRule(&in.plurals, pattern, replacement)
+}
+
+/*
+ * AddSingular registers a rule turning plural words matching pattern into their singular.
+ * @param pattern string
+ * @param replacement string
+ * @return error if the pattern is not a valid regular expression
+ */
+func (in *Inflector) AddSingular(pattern, replacement string) error {
+	return in.addRule(&in.singulars, pattern, replacement)
+}
+
+/*
+ * AddIrregular registers a word pair which does not follow any rule.
+ * @param singular string
+ * @param plural string
+ */
+func (in *Inflector) AddIrregular(singular, plural string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+	in.irregulars[singular] = plural
+	in.irregularsR[plural] = singular
+}
+
+/*
+ * AddUncountable registers words which have the same singular and plural form.
+ * @param words ...string
+ */
+func (in *Inflector) AddUncountable(words ...string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	for _, word := range words {
+		in.uncountables[strings.ToLower(word)] = struct{}{}
+	}
+}
+
+/*
+ * Pluralize returns the plural form of word.
+ * @param word string
+ * @return string
+ * Example: "Person" => "People", "category" => "categories", "BlogPost" => "BlogPosts"
+ */
+func (in *Inflector) Pluralize(word string) string {
+	return in.inflect(word, true)
+}
+
+/*
+ * Singularize returns the singular form of word.
+ * @param word string
+ * @return string
+ * Example: "people" => "person", "indices" => "index", "user_categories" => "user_category"
+ */
+func (in *Inflector) Singularize(word string) string {
+	return in.inflect(word, false)
+}
+
+/*
+ * inflect is a helper function to apply rules to the last word of input preserving its case.
+ * The rule set is chosen under the lock as registering a rule may replace it.
+ * @param input string
+ * @param plural bool true for the plural form, false for the singular form
+ * @return string
+ */
+func (in *Inflector) inflect(input string, plural bool) string {
+	prefix, word := splitLastWord(input)
+	if word == "" {
+		return input
+	}
+	lower := strings.ToLower(word)
+
+	in.mu.RLock()
+	defer in.mu.RUnlock()
+
+	rules, irregular, inflected := in.singulars, in.irregularsR, in.irregulars
+	if plural {
+		rules, irregular, inflected = in.plurals, in.irregulars, in.irregularsR
+	}
+
+	if _, ok := in.uncountables[lower]; ok {
+		return input
+	}
+	if _, ok := inflected[lower]; ok {
+		return input
+	}
+	if replacement, ok := irregular[lower]; ok {
+		return prefix + matchCase(word, replacement)
+	}
+	for idx := len(rules) - 1; idx >= 0; idx-- {
+		if rules[idx].pattern.MatchString(lower) {
+			result := rules[idx].pattern.ReplaceAllString(lower, rules[idx].replacement)
+			return prefix + matchCase(word, result)
+		}
+	}
+	return input
+}
+
+/*
+ * splitLastWord is a helper function to split input before its last word. Words are
+ * separated by spaces, "_", "-", ".", a lower to upper case transition or, like SnakeCase
+ * does, the end of an acronym ("HTTPStatus"). A single trailing lowercase letter stays with
+ * the acronym so plurals like "APIs" are kept together.
+ * @param input string
+ * @return prefix string everything before the last word
+ * @return word string the last word
+ */
+func splitLastWord(input string) (prefix, word string) {
+	runes := []rune(input)
+	start := 0
+	for idx := len(runes) - 1; idx >= 0; idx-- {
+		r := runes[idx]
+		if unicode.IsSpace(r) || r == '_' || r == '-' || r == '.' {
+			start = idx + 1
+			break
+		}
+		if idx > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[idx-1]) {
+			start = idx
+			break
+		}
+		if idx > 0 && idx+2 < len(runes) && unicode.IsUpper(r) && unicode.IsUpper(runes[idx-1]) &&
+			unicode.IsLower(runes[idx+1]) && unicode.IsLower(runes[idx+2]) {
+			start = idx
+			break
+		}
+	}
+	return string(runes[:start]), string(runes[start:])
+}
+
+/*
+ * matchCase is a helper function to apply the casing of word to result. The part of result
+ * which word shares keeps its original casing and the rest is lowercase, so "API" becomes
+ * "APIs" and "Person" becomes "People". An UPPER word whose stem changed is made UPPER.
+ * @param word string
+ * @param result string lowercase word
+ * @return string
+ */
+func matchCase(word, result string) string {
+	runes, inflected := []rune(word), []rune(result)
+	shared := 0
+	for shared < len(runes) && shared < len(inflected) && unicode.ToLower(runes[shared]) == inflected[shared] {
+		shared++
+	}
+	if len(runes) > 1 && shared < len(runes) && strings.ToUpper(word) == word {
+		return strings.ToUpper(result)
+	}
+	if shared == 0 && len(runes) > 0 && len(inflected) > 0 && unicode.IsUpper(runes[0]) {
+		inflected[0] = unicode.ToUpper(inflected[0])
+		return string(inflected)
+	}
+	return string(runes[:shared]) + string(inflected[shared:])
+}
+
+/*
+ * ordinalSuffix is a helper function to return the English ordinal suffix of n.
+ * @param n int64
+ * @return string "st", "nd", "rd" or "th"
+ */
+func ordinalSuffix(n int64) string {
+	if n < 0 {
+		n = -n
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
+
+/*
+ * Ordinalize returns n followed by its English ordinal suffix.
+ * @param n int64
+ * @return string
+ * Example: Ordinalize(1) => "1st", Ordinalize(12) => "12th", Ordinalize(23) => "23rd"
+ */
+func Ordinalize(n int64) string {
+	return strconv.FormatInt(n, 10) + ordinalSuffix(n)
+}
+
+/*
+* Pluralize converts the last word of the input to its plural form using DefaultInflector
+* it can be chained on function which return StringManipulation interface
+* @return StringManipulation
+* Example: "Person" => "People", "UserCategory" => "UserCategories", "user_index" => "user_indices"
+ */
+func (i *input) Pluralize() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	setResult(i, DefaultInflector.Pluralize(getInput(*i)))
+	return i
+}
+
+/*
+* Singularize converts the last word of the input to its singular form using DefaultInflector
+* it can be chained on function which return StringManipulation interface
+* @return StringManipulation
+* Example: "people" => "person", "UserCategories" => "UserCategory"
+ */
+func (i *input) Singularize() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	setResult(i, DefaultInflector.Singularize(getInput(*i)))
+	return i
+}
+
+/*
+* Ordinalize appends the English ordinal suffix to a numeric input
+* it can be chained on function which return StringManipulation interface
+* @return StringManipulation
+* Note: If the input is not an integer, it sets an error.
+* Example: "1" => "1st", " 22 " => "22nd", "1,013" => "1,013th", "1.5k" => "1500th"
+ */
+func (i *input) Ordinalize() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	n, err := parseInt(input)
+	if err != nil {
+		i.err = newOpError("Ordinalize", input, nil, err)
+		setResult(i, "")
+		return i
+	}
+	if _, err := strconv.ParseInt(numberReplacer.Replace(strings.TrimSpace(input)), 10, 64); err != nil {
+		// "1.5k" or "1e3" are ordinalized as the integer they stand for
+		setResult(i, strconv.FormatInt(n, 10)+ordinalSuffix(n))
+		return i
+	}
+	setResult(i, strings.TrimSpace(input)+ordinalSuffix(n))
+	return i
+}
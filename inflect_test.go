@@ -0,0 +1,178 @@
+package stringy
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// inflections holds singular and plural pairs of the default English ruleset
+var inflections = [][2]string{
+	{"person", "people"},
+	{"Person", "People"},
+	{"category", "categories"},
+	{"index", "indices"},
+	{"matrix", "matrices"},
+	{"user", "users"},
+	{"box", "boxes"},
+	{"status", "statuses"},
+	{"mouse", "mice"},
+	{"child", "children"},
+	{"knife", "knives"},
+	{"wolf", "wolves"},
+	{"analysis", "analyses"},
+	{"datum", "data"},
+	{"tomato", "tomatoes"},
+	{"bus", "buses"},
+	{"quiz", "quizzes"},
+	{"ox", "oxen"},
+	{"day", "days"},
+	{"sheep", "sheep"},
+	{"equipment", "equipment"},
+	{"API", "APIs"},
+	{"UserID", "UserIDs"},
+	{"HTTPStatus", "HTTPStatuses"},
+	{"XMLHttpRequest", "XMLHttpRequests"},
+	{"PERSON", "PEOPLE"},
+	{"CATEGORY", "CATEGORIES"},
+	{"BlogPost", "BlogPosts"},
+	{"UserCategory", "UserCategories"},
+	{"user_category", "user_categories"},
+	{"api-key", "api-keys"},
+	{"SalesPerson", "SalesPeople"},
+	{"order item", "order items"},
+}
+
+// Test Pluralize and Singularize with the default English ruleset
+func TestInflector_Default(t *testing.T) {
+	for _, pair := range inflections {
+		t.Run(pair[0], func(t *testing.T) {
+			if val := DefaultInflector.Pluralize(pair[0]); val != pair[1] {
+				t.Errorf("Pluralize - Expected: %s but got: %s", pair[1], val)
+			}
+			if val := DefaultInflector.Singularize(pair[1]); val != pair[0] {
+				t.Errorf("Singularize - Expected: %s but got: %s", pair[0], val)
+			}
+			// already inflected words are left alone
+			if val := DefaultInflector.Pluralize(pair[1]); val != pair[1] {
+				t.Errorf("Pluralize plural - Expected: %s but got: %s", pair[1], val)
+			}
+		})
+	}
+}
+
+// Test user registered rules
+func TestInflector_Rules(t *testing.T) {
+	in := NewEnglishInflector()
+	if err := in.AddPlural(`(cact)us$`, "${1}i"); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if err := in.AddSingular(`(cact)i$`, "${1}us"); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	in.AddIrregular("Octopus", "Octopodes")
+	in.AddUncountable("Pokemon")
+
+	testCases := [][2]string{
+		{"cactus", "cacti"},
+		{"octopus", "octopodes"},
+		{"pokemon", "pokemon"},
+	}
+	for _, tc := range testCases {
+		if val := in.Pluralize(tc[0]); val != tc[1] {
+			t.Errorf("Pluralize - Expected: %s but got: %s", tc[1], val)
+		}
+		if val := in.Singularize(tc[1]); val != tc[0] {
+			t.Errorf("Singularize - Expected: %s but got: %s", tc[0], val)
+		}
+	}
+	// the default inflector is not affected
+	if val := DefaultInflector.Singularize("cacti"); val != "cacti" {
+		t.Errorf("Expected: cacti but got: %s", val)
+	}
+	if err := in.AddPlural(`(`, ""); err == nil {
+		t.Errorf("Expected error for invalid pattern")
+	}
+
+	empty := NewInflector()
+	if val := empty.Pluralize("person"); val != "person" {
+		t.Errorf("Expected empty inflector to return input but got: %s", val)
+	}
+}
+
+// Test registering rules while the inflector is in use
+func TestInflector_Concurrency(t *testing.T) {
+	in := NewEnglishInflector()
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(4)
+		go func() {
+			defer wg.Done()
+			in.AddUncountable("aircraft")
+		}()
+		go func() {
+			defer wg.Done()
+			if err := in.AddPlural(`(cact)us$`, "${1}i"); err != nil {
+				t.Error(err)
+			}
+			if err := in.AddSingular(`(cact)i$`, "${1}us"); err != nil {
+				t.Error(err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if val := in.Pluralize("person"); val != "people" {
+				t.Errorf("Expected: people but got: %s", val)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if val := in.Singularize("users"); val != "user" {
+				t.Errorf("Expected: user but got: %s", val)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Test Ordinalize
+func TestOrdinalize(t *testing.T) {
+	testCases := map[int64]string{
+		0: "0th", 1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th",
+		21: "21st", 22: "22nd", 101: "101st", 111: "111th", 1002: "1002nd", -1: "-1st",
+	}
+	for n, expected := range testCases {
+		if val := Ordinalize(n); val != expected {
+			t.Errorf("Ordinalize(%d) - Expected: %s but got: %s", n, expected, val)
+		}
+	}
+}
+
+// Test Pluralize, Singularize and Ordinalize chain methods
+func TestInput_Inflection(t *testing.T) {
+	if val := New("user profile").PascalCase().Pluralize().Get(); val != "UserProfiles" {
+		t.Errorf("Expected: UserProfiles but got: %s", val)
+	}
+	if val := New("UserCategories").Singularize().SnakeCase().ToLower(); val != "user_category" {
+		t.Errorf("Expected: user_category but got: %s", val)
+	}
+	if val := Of("Person").Pluralize().ToLower(); val != "people" {
+		t.Errorf("Expected: people but got: %s", val)
+	}
+	if val := New(" 22 ").Ordinalize().Get(); val != "22nd" {
+		t.Errorf("Expected: 22nd but got: %s", val)
+	}
+	if val := New("1,013").Ordinalize().Get(); val != "1,013th" {
+		t.Errorf("Expected: 1,013th but got: %s", val)
+	}
+	if val := New("1.5k").Ordinalize().Get(); val != "1500th" {
+		t.Errorf("Expected: 1500th but got: %s", val)
+	}
+	if val := DefaultInflector.Singularize("USERS"); val != "USER" {
+		t.Errorf("Expected: USER but got: %s", val)
+	}
+	sm := New("first").Ordinalize()
+	if !errors.Is(sm.Error(), ErrInvalidNumber) {
+		t.Errorf("Expected ErrInvalidNumber but got: %v", sm.Error())
+	}
+}
@@ -0,0 +1,422 @@
+package stringy
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// InterpolateOption changes how placeholders are expanded by Interpolate, InterpolateWith and ExpandEnv
+type InterpolateOption int
+
+// const below are the available interpolate options
+const (
+	// Strict records an ErrMissingKey error listing the placeholders without value and default
+	Strict InterpolateOption = iota + 1
+	// DollarOnly only expands ${key} placeholders and keeps {key} as it is
+	DollarOnly
+)
+
+// Resolver looks up the value of a placeholder key, ok is false if the key is unknown
+type Resolver interface {
+	Resolve(key string) (val string, ok bool)
+}
+
+// ResolverFunc adapts a lookup function like os.LookupEnv to a Resolver
+type ResolverFunc func(key string) (string, bool)
+
+// Resolve calls f(key)
+func (f ResolverFunc) Resolve(key string) (string, bool) {
+	return f(key)
+}
+
+// MapResolver resolves keys from a flat map, "user.id" is looked up as it is
+type MapResolver map[string]string
+
+// Resolve returns the value of key in the map
+func (m MapResolver) Resolve(key string) (string, bool) {
+	val, ok := m[key]
+	return val, ok
+}
+
+/*
+ * NestedResolver resolves dotted keys by walking nested maps, e.g. "user.id" is the "id" of
+ * the map stored at "user". A key present as it is wins over the nested lookup. Values of
+ * map[string]interface{} and map[string]string are walked, other values are formatted with fmt.
+ * Example: NestedResolver{"user": map[string]interface{}{"id": 42}}.Resolve("user.id") => "42", true
+ */
+type NestedResolver map[string]interface{}
+
+// Resolve returns the formatted value at the dotted path key
+func (n NestedResolver) Resolve(key string) (string, bool) {
+	val, ok := lookupNested(map[string]interface{}(n), key)
+	if !ok || val == nil {
+		return "", false
+	}
+	return fmt.Sprint(val), true
+}
+
+/*
+ * lookupNested is a helper function to look key up in data, descending into the value of
+ * its first segment if data has no entry for the whole key.
+ * @param data interface{}
+ * @param key string
+ * @return interface{}
+ * @return bool
+ */
+func lookupNested(data interface{}, key string) (interface{}, bool) {
+	switch m := data.(type) {
+	case map[string]interface{}:
+		if val, ok := m[key]; ok {
+			return val, true
+		}
+		if dot := strings.IndexByte(key, '.'); dot >= 0 {
+			if child, ok := m[key[:dot]]; ok {
+				return lookupNested(child, key[dot+1:])
+			}
+		}
+	case map[string]string:
+		val, ok := m[key]
+		return val, ok
+	}
+	return nil, false
+}
+
+// EnvResolver resolves keys from the environment of the process
+var EnvResolver Resolver = ResolverFunc(os.LookupEnv)
+
+// interpolator expands the placeholders of a text and collects the keys without value
+type interpolator struct {
+	resolver   Resolver
+	dollarOnly bool
+	missing    []string
+}
+
+/*
+ * newInterpolator is a helper function to resolve interpolate options.
+ * @param resolver Resolver, nil resolves no key
+ * @param opts []InterpolateOption
+ * @return *interpolator
+ * @return bool true if the interpolation is strict
+ */
+func newInterpolator(resolver Resolver, opts []InterpolateOption) (*interpolator, bool) {
+	if resolver == nil {
+		resolver = MapResolver{}
+	}
+	ip := &interpolator{resolver: resolver}
+	strict := false
+	for _, opt := range opts {
+		switch opt {
+		case Strict:
+			strict = true
+		case DollarOnly:
+			ip.dollarOnly = true
+		}
+	}
+	return ip, strict
+}
+
+/*
+ * expand is a helper function to replace the placeholders of text. A backslash escapes
+ * "$", "{", "}" and itself, before any other character it is kept.
+ * @param text string
+ * @return string
+ */
+func (ip *interpolator) expand(text string) string {
+	var sb strings.Builder
+	for pos := 0; pos < len(text); {
+		c := text[pos]
+		switch {
+		case c == '\\' && strings.HasPrefix(text[pos+1:], "${"):
+			// an escaped ${key} is kept as a whole instead of turning into $ and {key}
+			sb.WriteString("${")
+			pos += 3
+			continue
+		case c == '\\' && pos+1 < len(text) && strings.IndexByte(`${}\`, text[pos+1]) >= 0:
+			sb.WriteByte(text[pos+1])
+			pos += 2
+			continue
+		case c == '$' && pos+1 < len(text) && text[pos+1] == '{':
+			if end := closingBrace(text, pos+2); end >= 0 {
+				sb.WriteString(ip.placeholder(text[pos:end+1], text[pos+2:end]))
+				pos = end + 1
+				continue
+			}
+		case c == '{' && !ip.dollarOnly:
+			if end := strings.IndexByte(text[pos+1:], '}'); end >= 0 && isInterpolationKey(text[pos+1:pos+1+end]) {
+				sb.WriteString(ip.placeholder(text[pos:pos+end+2], text[pos+1:pos+1+end]))
+				pos += end + 2
+				continue
+			}
+		}
+		sb.WriteByte(c)
+		pos++
+	}
+	return sb.String()
+}
+
+/*
+ * placeholder is a helper function to return the value of the placeholder raw with the
+ * expression expr, "key" or "key:-default". The default is used when the key is unknown or
+ * empty and may contain placeholders itself. An unknown key without default is recorded as
+ * missing and the placeholder is kept.
+ * @param raw string
+ * @param expr string
+ * @return string
+ */
+func (ip *interpolator) placeholder(raw, expr string) string {
+	key, def, hasDefault := splitDefault(expr)
+	if val, ok := ip.lookup(key, hasDefault); ok {
+		return val
+	}
+	if hasDefault {
+		return ip.expand(def)
+	}
+	return raw
+}
+
+/*
+ * lookup is a helper function to resolve key, recording it as missing if it has no value and no default.
+ * @param key string
+ * @param hasDefault bool
+ * @return string
+ * @return bool false if the default or the placeholder has to be used
+ */
+func (ip *interpolator) lookup(key string, hasDefault bool) (string, bool) {
+	val, ok := ip.resolver.Resolve(key)
+	if ok && (val != "" || !hasDefault) {
+		return val, true
+	}
+	if !ok && !hasDefault && !containsString(ip.missing, key) {
+		ip.missing = append(ip.missing, key)
+	}
+	return "", false
+}
+
+/*
+ * expandEnv is a helper function to replace $var, ${var} and ${var:-default} like os.Expand.
+ * The default may contain variables itself, e.g. "${A:-${B:-b}}". Unset variables without
+ * default expand to an empty string.
+ * @param text string
+ * @return string
+ */
+func (ip *interpolator) expandEnv(text string) string {
+	var sb strings.Builder
+	for pos := 0; pos < len(text); pos++ {
+		if text[pos] != '$' || pos+1 == len(text) {
+			sb.WriteByte(text[pos])
+			continue
+		}
+		name, width := envName(text[pos+1:])
+		if name == "" && width == 0 {
+			sb.WriteByte('$')
+			continue
+		}
+		if name != "" {
+			key, def, hasDefault := splitDefault(name)
+			if val, ok := ip.lookup(key, hasDefault); ok {
+				sb.WriteString(val)
+			} else {
+				sb.WriteString(ip.expandEnv(def))
+			}
+		}
+		pos += width
+	}
+	return sb.String()
+}
+
+/*
+ * envName is a helper function to read the variable name or ${...} expression following a "$"
+ * the way os.Expand does, except that the closing brace of ${...} may follow nested braces.
+ * @param text string text after the "$"
+ * @return string name, empty for invalid syntax like "${}"
+ * @return int bytes consumed, 0 if the "$" is kept as it is
+ */
+func envName(text string) (string, int) {
+	if text[0] == '{' {
+		if len(text) > 2 && isShellSpecial(text[1]) && text[2] == '}' {
+			return text[1:2], 3
+		}
+		end := closingBrace(text, 1)
+		if end < 0 {
+			return "", 1
+		}
+		if end == 1 {
+			return "", 2
+		}
+		return text[1:end], end + 1
+	}
+	if isShellSpecial(text[0]) {
+		return text[:1], 1
+	}
+	n := 0
+	for n < len(text) && (text[n] == '_' || isAlphaNumericASCII(rune(text[n]))) {
+		n++
+	}
+	return text[:n], n
+}
+
+// isShellSpecial is a helper function to check for a single character shell variable like $1 or $$
+func isShellSpecial(c byte) bool {
+	return strings.IndexByte("*#$@!?-", c) >= 0 || (c >= '0' && c <= '9')
+}
+
+/*
+ * splitDefault is a helper function to split a placeholder expression into key and default.
+ * @param expr string
+ * @return string key
+ * @return string default
+ * @return bool true if expr has a default
+ * Example: splitDefault("name:-guest") => "name", "guest", true
+ */
+func splitDefault(expr string) (string, string, bool) {
+	if idx := strings.Index(expr, ":-"); idx >= 0 {
+		return expr[:idx], expr[idx+2:], true
+	}
+	return expr, "", false
+}
+
+/*
+ * closingBrace is a helper function to find the "}" closing a placeholder whose content starts
+ * at byte offset from, skipping nested braces and escaped characters.
+ * @param text string
+ * @param from int byte offset
+ * @return int byte offset of the closing brace, -1 if there is none
+ */
+func closingBrace(text string, from int) int {
+	depth := 0
+	for pos := from; pos < len(text); pos++ {
+		switch text[pos] {
+		case '\\':
+			pos++
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return pos
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+/*
+ * isInterpolationKey is a helper function to check if key can be used in a {key} placeholder,
+ * so braces in other text, e.g. JSON, are not mistaken for placeholders.
+ * @param key string
+ * @return bool
+ */
+func isInterpolationKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if !isAlphaNumericASCII(r) && r != '_' && r != '.' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ * containsString is a helper function to check if values holds val.
+ * @param values []string
+ * @param val string
+ * @return bool
+ */
+func containsString(values []string, val string) bool {
+	for _, v := range values {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+ * interpolateResult is a helper function to store the expanded value, or the missing keys if
+ * the interpolation is strict.
+ * @param i *input
+ * @param op string name of the method
+ * @param input string
+ * @param result string
+ * @param missing []string
+ * @param strict bool
+ * @return StringManipulation
+ */
+func interpolateResult(i *input, op, input, result string, missing []string, strict bool) StringManipulation {
+	if strict && len(missing) > 0 {
+		i.err = newOpError(op, input, missing, ErrMissingKey)
+		return i
+	}
+	setResult(i, result)
+	return i
+}
+
+/*
+ * interpolate is a helper function to expand the placeholders of the value for the operation op.
+ * @param i *input
+ * @param op string name of the method
+ * @param resolver Resolver
+ * @param opts []InterpolateOption
+ * @return StringManipulation
+ */
+func interpolate(i *input, op string, resolver Resolver, opts []InterpolateOption) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	ip, strict := newInterpolator(resolver, opts)
+	return interpolateResult(i, op, input, ip.expand(input), ip.missing, strict)
+}
+
+/*
+ * Interpolate replaces the ${key}, ${key:-default} and {key} placeholders of the value with
+ * the values of vars. The default is used when the key is unknown or empty and may contain
+ * placeholders itself. Placeholders without value are kept, unless Strict is passed which
+ * records an ErrMissingKey error listing their keys. A backslash escapes "$", "{", "}" and
+ * itself. Pass DollarOnly to keep {key} as it is.
+ * it can be chained on function which return StringManipulation interface
+ * @param vars map[string]string
+ * @param opts ...InterpolateOption
+ * @return StringManipulation
+ * Example: "Hi ${name:-guest}, order {order.id}" => Interpolate(map[string]string{"order.id": "42"}) => "Hi guest, order 42"
+ */
+func (i *input) Interpolate(vars map[string]string, opts ...InterpolateOption) StringManipulation {
+	return interpolate(i, "Interpolate", MapResolver(vars), opts)
+}
+
+/*
+ * InterpolateWith works like Interpolate but looks the keys up with resolver, e.g. a
+ * NestedResolver for nested data or a ResolverFunc for a custom source.
+ * it can be chained on function which return StringManipulation interface
+ * @param resolver Resolver, nil resolves no key
+ * @param opts ...InterpolateOption
+ * @return StringManipulation
+ * Example: "id: {user.id}" => InterpolateWith(NestedResolver{"user": map[string]interface{}{"id": 42}}) => "id: 42"
+ */
+func (i *input) InterpolateWith(resolver Resolver, opts ...InterpolateOption) StringManipulation {
+	return interpolate(i, "InterpolateWith", resolver, opts)
+}
+
+/*
+ * ExpandEnv replaces $var and ${var} with the values of environment variables like
+ * os.ExpandEnv, and additionally supports ${var:-default} where the default may contain
+ * variables itself. Unset variables expand to an empty string, unless Strict is passed which
+ * records an ErrMissingKey error listing them.
+ * it can be chained on function which return StringManipulation interface
+ * @param opts ...InterpolateOption
+ * @return StringManipulation
+ * Example: "${HOME}/.config" => ExpandEnv() => "/home/jane/.config"
+ */
+func (i *input) ExpandEnv(opts ...InterpolateOption) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	ip, strict := newInterpolator(EnvResolver, opts)
+	return interpolateResult(i, "ExpandEnv", input, ip.expandEnv(input), ip.missing, strict)
+}
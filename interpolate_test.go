@@ -0,0 +1,128 @@
+package stringy
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// Test Interpolate with the placeholder styles, defaults and escapes
+func TestInput_Interpolate(t *testing.T) {
+	vars := map[string]string{"name": "Jane", "user.id": "42", "empty": "", "fallback": "Guest"}
+	testCases := []struct {
+		name     string
+		input    string
+		opts     []InterpolateOption
+		expected string
+	}{
+		{"Dollar", "Hi ${name}", nil, "Hi Jane"},
+		{"Braces", "Hi {name}, id {user.id}", nil, "Hi Jane, id 42"},
+		{"Default", "Hi ${nick:-there}", nil, "Hi there"},
+		{"DefaultEmpty", "[${empty:-none}]", nil, "[none]"},
+		{"EmptyWithoutDefault", "[${empty}]", nil, "[]"},
+		{"NestedDefault", "Hi ${nick:-${fallback}}", nil, "Hi Guest"},
+		{"DefaultWithBraces", "Hi ${nick:-{name}}", nil, "Hi Jane"},
+		{"Missing", "Hi ${nick} {nick}", nil, "Hi ${nick} {nick}"},
+		{"EscapeDollar", `cost \${name}`, nil, "cost ${name}"},
+		{"EscapeBrace", `\{name\}`, nil, "{name}"},
+		{"EscapeBackslash", `C:\\{name}`, nil, `C:\Jane`},
+		{"KeepBackslash", `C:\temp\{name}`, nil, `C:\temp{name}`},
+		{"JSON", `{"name": "{name}"}`, nil, `{"name": "Jane"}`},
+		{"Unterminated", "Hi ${name", nil, "Hi ${name"},
+		{"DollarOnly", "${name} {name}", []InterpolateOption{DollarOnly}, "Jane {name}"},
+		{"Unicode", "¡Hola {name}! ✓", nil, "¡Hola Jane! ✓"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if val := New(tc.input).Interpolate(vars, tc.opts...).Get(); val != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, val)
+			}
+		})
+	}
+
+	if val := New("{a}").Interpolate(map[string]string{"a": "${name}", "name": "Jane"}).Get(); val != "${name}" {
+		t.Errorf("Expected values not to be expanded again but got: %s", val)
+	}
+}
+
+// Test strict interpolation reports every missing key once
+func TestInput_InterpolateStrict(t *testing.T) {
+	val, err := New("Hi {name} ${id} {name} ${nick:-you}").Interpolate(map[string]string{}, Strict).GetE()
+	if val != "" || !errors.Is(err, ErrMissingKey) {
+		t.Fatalf("Expected ErrMissingKey but got: %q, %v", val, err)
+	}
+	var opErr *OpError
+	if !errors.As(err, &opErr) || !reflect.DeepEqual(opErr.Arg, []string{"name", "id"}) {
+		t.Errorf("Expected the missing keys name and id but got: %v", err)
+	}
+
+	if val, err := Of("Hi {name}").Interpolate(map[string]string{"name": "Jane"}, Strict).GetE(); val != "Hi Jane" || err != nil {
+		t.Errorf("Expected: Hi Jane but got: %q, %v", val, err)
+	}
+}
+
+// Test InterpolateWith with nested and custom resolvers
+func TestInput_InterpolateWith(t *testing.T) {
+	data := NestedResolver{
+		"user":    map[string]interface{}{"id": 42, "name": map[string]string{"first": "Jane"}},
+		"app.env": "prod",
+		"nil":     nil,
+	}
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"{user.id}", "42"},
+		{"{user.name.first}", "Jane"},
+		{"{app.env}", "prod"},
+		{"{user.missing}", "{user.missing}"},
+		{"{user.id.deeper}", "{user.id.deeper}"},
+		{"${nil:-none}", "none"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if val := New(tc.input).InterpolateWith(data).Get(); val != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, val)
+			}
+		})
+	}
+
+	upper := ResolverFunc(func(key string) (string, bool) { return New(key).ToUpper(), true })
+	if val := New("{a}-${b}").InterpolateWith(upper).Get(); val != "A-B" {
+		t.Errorf("Expected: A-B but got: %s", val)
+	}
+
+	if val := New("{a} ${b:-x}").InterpolateWith(nil).Get(); val != "{a} x" {
+		t.Errorf("Expected a nil resolver to resolve no key but got: %s", val)
+	}
+	_, err := New("{a}").InterpolateWith(nil, Strict).GetE()
+	if !errors.Is(err, ErrMissingKey) {
+		t.Errorf("Expected ErrMissingKey but got: %v", err)
+	}
+}
+
+// Test ExpandEnv matches os.ExpandEnv and supports defaults and strict mode
+func TestInput_ExpandEnv(t *testing.T) {
+	os.Setenv("STRINGY_TEST_HOME", "/home/jane")
+	os.Unsetenv("STRINGY_TEST_UNSET")
+	defer os.Unsetenv("STRINGY_TEST_HOME")
+
+	for _, input := range []string{"$STRINGY_TEST_HOME/.config", "${STRINGY_TEST_HOME}x", "$STRINGY_TEST_UNSET-", "cost $", "${}", "${STRINGY_TEST_HOME", "$1 $$", "${@}", "$$STRINGY_TEST_HOME", "a$_b${STRINGY_TEST_HOME}}"} {
+		if val := New(input).ExpandEnv().Get(); val != os.ExpandEnv(input) {
+			t.Errorf("Expected: %q like os.ExpandEnv but got: %q", os.ExpandEnv(input), val)
+		}
+	}
+	if val := New("${STRINGY_TEST_UNSET:-/tmp}/cache").ExpandEnv().Get(); val != "/tmp/cache" {
+		t.Errorf("Expected: /tmp/cache but got: %s", val)
+	}
+	if val := New("${STRINGY_TEST_UNSET:-${STRINGY_TEST_HOME:-y}}/x ${STRINGY_TEST_UNSET:-${STRINGY_TEST_UNSET:-y}}").ExpandEnv().Get(); val != "/home/jane/x y" {
+		t.Errorf("Expected: /home/jane/x y but got: %s", val)
+	}
+
+	_, err := New("$STRINGY_TEST_HOME/$STRINGY_TEST_UNSET").ExpandEnv(Strict).GetE()
+	var opErr *OpError
+	if !errors.As(err, &opErr) || !errors.Is(err, ErrMissingKey) || !reflect.DeepEqual(opErr.Arg, []string{"STRINGY_TEST_UNSET"}) {
+		t.Errorf("Expected STRINGY_TEST_UNSET to be reported but got: %v", err)
+	}
+}
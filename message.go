@@ -2,20 +2,35 @@ package stringy
 
 // const below are used in packages
 const (
-	First                = "first"
-	Last                 = "last"
-	Left                 = "left"
-	Right                = "right"
-	Both                 = "both"
-	OddError             = "odd number rule provided please provide in even count"
-	SelectCapital        = "([a-z])([A-Z])"
-	ReplaceCapital       = "$1 $2"
-	LengthError          = "passed length cannot be greater than input length"
-	InvalidLogicalString = "invalid string value to test boolean value"
+	First                 = "first"
+	Last                  = "last"
+	Left                  = "left"
+	Right                 = "right"
+	Both                  = "both"
+	OddError              = "odd number rule provided please provide in even count"
+	SelectCapital         = "([a-z])([A-Z])"
+	ReplaceCapital        = "$1 $2"
+	LengthError           = "passed length cannot be greater than input length"
+	InvalidLogicalString  = "invalid string value to test boolean value"
+	NegativeLengthError   = "length cannot be negative"
+	InvalidRangeError     = "start position cannot be greater than end position"
+	InvalidNumberError    = "invalid string value to convert to number"
+	InvalidDurationError  = "invalid string value to convert to duration"
+	InvalidByteSizeError  = "invalid string value to convert to byte size"
+	OutOfRangeError       = "value out of range"
+	InvalidRomanError     = "invalid roman numeral"
+	LengthMismatchError   = "strings must have the same length"
+	EmptyCharsetError     = "charset cannot be empty"
+	ValidationFailedError = "value does not satisfy the validation rule"
+	InvalidPatternError   = "invalid pattern"
+	MissingKeyError       = "no value for placeholder"
+	UseAfterReleaseError  = "stringy: use of StringManipulation after Release"
 )
 
-// False is slice of array for false logical representation in string
+// False is slice of array for false logical representation in string.
+// It is read once at start up, use NewBoolParser for a custom vocabulary.
 var False = []string{"off", "no", "0", "false"}
 
-// True is slice of array for true logical representation in string
+// True is slice of array for true logical representation in string.
+// It is read once at start up, use NewBoolParser for a custom vocabulary.
 var True = []string{"on", "yes", "1", "true"}
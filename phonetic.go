@@ -0,0 +1,957 @@
+package stringy
+
+import (
+	"strings"
+)
+
+// soundexCodes holds the Soundex digit of each letter from A to Z, 0 for letters without code
+const soundexCodes = "01230120022455012623010202"
+
+/*
+ * phoneticInput is a helper function to prepare input for the phonetic algorithms. It folds
+ * the input to ASCII, uppercases it and keeps letters only, or letters and single spaces
+ * between words when keepSpaces is true.
+ * @param input string
+ * @param keepSpaces bool
+ * @return string
+ * Example: phoneticInput("Müller-Lüdenscheidt", false) => "MULLERLUDENSCHEIDT"
+ */
+func phoneticInput(input string, keepSpaces bool) string {
+	folded := strings.ToUpper(asciiFold(input))
+	var result strings.Builder
+	result.Grow(len(folded))
+	space := false
+	for idx := 0; idx < len(folded); idx++ {
+		c := folded[idx]
+		if c >= 'A' && c <= 'Z' {
+			if space && result.Len() > 0 {
+				result.WriteByte(' ')
+			}
+			result.WriteByte(c)
+			space = false
+		} else if keepSpaces && c == ' ' {
+			space = true
+		}
+	}
+	return result.String()
+}
+
+/*
+ * isPhoneticVowel is a helper function to check if c is one of A, E, I, O, U.
+ * @param c byte
+ * @return bool
+ */
+func isPhoneticVowel(c byte) bool {
+	return c == 'A' || c == 'E' || c == 'I' || c == 'O' || c == 'U'
+}
+
+/*
+ * Soundex returns the American Soundex code of input: its first letter followed by three digits.
+ * @param input string
+ * @return string empty if input contains no letters
+ * Example: Soundex("Robert") => "R163", Soundex("Tymczak") => "T522", Soundex("Pfister") => "P236"
+ */
+func Soundex(input string) string {
+	word := phoneticInput(input, false)
+	if word == "" {
+		return ""
+	}
+	code := []byte{word[0]}
+	last := soundexCodes[word[0]-'A']
+	for idx := 1; idx < len(word) && len(code) < 4; idx++ {
+		c := word[idx]
+		digit := soundexCodes[c-'A']
+		if digit != '0' && digit != last {
+			code = append(code, digit)
+		}
+		// H and W do not separate letters with the same code, vowels do
+		if c != 'H' && c != 'W' {
+			last = digit
+		}
+	}
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return string(code)
+}
+
+/*
+ * Metaphone returns the original Metaphone key of input, as described by Lawrence Philips.
+ * The key is not truncated.
+ * @param input string
+ * @return string
+ * Example: Metaphone("Thumb") => "0M", Metaphone("knight") => "NT", Metaphone("fox") => "FKS"
+ */
+func Metaphone(input string) string {
+	word := phoneticInput(input, false)
+	if word == "" {
+		return ""
+	}
+
+	// initial exceptions
+	switch {
+	case strings.HasPrefix(word, "AE"), strings.HasPrefix(word, "GN"), strings.HasPrefix(word, "KN"),
+		strings.HasPrefix(word, "PN"), strings.HasPrefix(word, "WR"):
+		word = word[1:]
+	case word[0] == 'X':
+		word = "S" + word[1:]
+	case strings.HasPrefix(word, "WH"):
+		word = "W" + word[2:]
+	}
+
+	at := func(idx int) byte {
+		if idx < 0 || idx >= len(word) {
+			return 0
+		}
+		return word[idx]
+	}
+	var key strings.Builder
+	for idx := 0; idx < len(word); idx++ {
+		c := word[idx]
+		prev, next, after := at(idx-1), at(idx+1), at(idx+2)
+		if c == prev && c != 'C' {
+			continue
+		}
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if idx == 0 {
+				key.WriteByte(c)
+			}
+		case 'B':
+			if !(prev == 'M' && idx == len(word)-1) {
+				key.WriteByte('B')
+			}
+		case 'C':
+			switch {
+			case prev == 'S' && (next == 'I' || next == 'E' || next == 'Y'):
+				// silent in -SCI-, -SCE-, -SCY-
+			case next == 'I' && after == 'A':
+				key.WriteByte('X')
+			case next == 'I' || next == 'E' || next == 'Y':
+				key.WriteByte('S')
+			case next == 'H' && prev == 'S':
+				key.WriteByte('K')
+			case next == 'H':
+				key.WriteByte('X')
+			default:
+				key.WriteByte('K')
+			}
+		case 'D':
+			if next == 'G' && (after == 'E' || after == 'Y' || after == 'I') {
+				key.WriteByte('J')
+			} else {
+				key.WriteByte('T')
+			}
+		case 'G':
+			switch {
+			case prev == 'D' && (next == 'E' || next == 'Y' || next == 'I'):
+				// part of -DGE-, -DGY-, -DGI-
+			case next == 'H' && !(idx+1 == len(word)-1 || isPhoneticVowel(after)):
+				// silent in -GH- unless at the end or before a vowel
+			case next == 'N' && (idx+1 == len(word)-1 || (strings.HasPrefix(word[idx+1:], "NED") && idx+3 == len(word)-1)):
+				// silent in -GN and -GNED at the end
+			case (next == 'I' || next == 'E' || next == 'Y') && prev != 'G':
+				key.WriteByte('J')
+			default:
+				key.WriteByte('K')
+			}
+		case 'H':
+			// silent in CH, SH, PH, TH, GH and when not before a vowel
+			if strings.IndexByte("CSPTG", prev) == -1 && isPhoneticVowel(next) {
+				key.WriteByte('H')
+			}
+		case 'K':
+			if prev != 'C' {
+				key.WriteByte('K')
+			}
+		case 'P':
+			if next == 'H' {
+				key.WriteByte('F')
+			} else {
+				key.WriteByte('P')
+			}
+		case 'Q':
+			key.WriteByte('K')
+		case 'S':
+			if next == 'H' || (next == 'I' && (after == 'O' || after == 'A')) {
+				key.WriteByte('X')
+			} else {
+				key.WriteByte('S')
+			}
+		case 'T':
+			switch {
+			case next == 'I' && (after == 'O' || after == 'A'):
+				key.WriteByte('X')
+			case next == 'H':
+				key.WriteByte('0')
+			case next == 'C' && after == 'H':
+				// silent in -TCH-
+			default:
+				key.WriteByte('T')
+			}
+		case 'V':
+			key.WriteByte('F')
+		case 'W', 'Y':
+			if isPhoneticVowel(next) {
+				key.WriteByte(c)
+			}
+		case 'X':
+			key.WriteString("KS")
+		case 'Z':
+			key.WriteByte('S')
+		default:
+			// F, J, L, M, N and R are kept as they are
+			key.WriteByte(c)
+		}
+	}
+	return key.String()
+}
+
+/*
+ * NYSIIS returns the New York State Identification and Intelligence System key of input.
+ * The key is not truncated, take its first six characters for the original variant.
+ * @param input string
+ * @return string
+ * Example: NYSIIS("Macintosh") => "MCANT", NYSIIS("Knuth") => "NAT", NYSIIS("Bishop") => "BASAP"
+ */
+func NYSIIS(input string) string {
+	word := phoneticInput(input, false)
+	if word == "" {
+		return ""
+	}
+
+	// translate the first characters
+	switch {
+	case strings.HasPrefix(word, "MAC"):
+		word = "MCC" + word[3:]
+	case strings.HasPrefix(word, "KN"):
+		word = word[1:]
+	case strings.HasPrefix(word, "K"):
+		word = "C" + word[1:]
+	case strings.HasPrefix(word, "PH"), strings.HasPrefix(word, "PF"):
+		word = "FF" + word[2:]
+	case strings.HasPrefix(word, "SCH"):
+		word = "SSS" + word[3:]
+	}
+	// translate the last characters
+	if n := len(word); n >= 2 {
+		switch word[n-2:] {
+		case "EE", "IE":
+			word = word[:n-2] + "Y"
+		case "DT", "RT", "RD", "NT", "ND":
+			word = word[:n-2] + "D"
+		}
+	}
+
+	key := []byte{word[0]}
+	for idx := 1; idx < len(word); idx++ {
+		c := word[idx]
+		var next byte
+		if idx+1 < len(word) {
+			next = word[idx+1]
+		}
+		translated := string(c)
+		switch {
+		case c == 'E' && next == 'V':
+			translated = "AF"
+			idx++
+		case isPhoneticVowel(c):
+			translated = "A"
+		case c == 'Q':
+			translated = "G"
+		case c == 'Z':
+			translated = "S"
+		case c == 'M':
+			translated = "N"
+		case c == 'K':
+			if next == 'N' {
+				translated = "N"
+			} else {
+				translated = "C"
+			}
+		case c == 'S' && strings.HasPrefix(word[idx+1:], "CH"):
+			translated = "SS"
+			idx += 2
+		case c == 'P' && next == 'H':
+			translated = "F"
+			idx++
+		case c == 'H' && (!isPhoneticVowel(word[idx-1]) || !isPhoneticVowel(next)):
+			if isPhoneticVowel(word[idx-1]) {
+				translated = "A"
+			} else {
+				translated = string(word[idx-1])
+			}
+		case c == 'W' && isPhoneticVowel(word[idx-1]):
+			translated = "A"
+		}
+		if translated[len(translated)-1] != key[len(key)-1] {
+			key = append(key, translated...)
+		}
+	}
+
+	result := string(key)
+	if len(result) > 1 && strings.HasSuffix(result, "S") {
+		result = result[:len(result)-1]
+	}
+	if strings.HasSuffix(result, "AY") {
+		result = result[:len(result)-2] + "Y"
+	}
+	if len(result) > 1 && strings.HasSuffix(result, "A") {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+/*
+* Soundex returns the American Soundex code of the input
+* it can be chained on function which return StringManipulation interface
+* @return string
+* Example: "Robert" => Soundex() => "R163"
+ */
+func (i *input) Soundex() string {
+	if i.err != nil {
+		return ""
+	}
+
+	return Soundex(getInput(*i))
+}
+
+/*
+* Metaphone returns the original Metaphone key of the input
+* it can be chained on function which return StringManipulation interface
+* @return string
+* Example: "Thumb" => Metaphone() => "0M"
+ */
+func (i *input) Metaphone() string {
+	if i.err != nil {
+		return ""
+	}
+
+	return Metaphone(getInput(*i))
+}
+
+/*
+* DoubleMetaphone returns the primary and alternate Double Metaphone keys of the input
+* it can be chained on function which return StringManipulation interface
+* @return string primary key
+* @return string alternate key
+* Example: "Smith" => DoubleMetaphone() => "SM0", "XMT"
+ */
+func (i *input) DoubleMetaphone() (string, string) {
+	if i.err != nil {
+		return "", ""
+	}
+
+	return DoubleMetaphone(getInput(*i))
+}
+
+/*
+* NYSIIS returns the NYSIIS key of the input
+* it can be chained on function which return StringManipulation interface
+* @return string
+* Example: "Macintosh" => NYSIIS() => "MCANT"
+ */
+func (i *input) NYSIIS() string {
+	if i.err != nil {
+		return ""
+	}
+
+	return NYSIIS(getInput(*i))
+}
+
+// doubleMetaphone holds the state of a Double Metaphone encoding
+type doubleMetaphone struct {
+	word          string
+	length        int
+	slavoGermanic bool
+	primary       strings.Builder
+	alternate     strings.Builder
+}
+
+/*
+ * at is a helper function to get the byte of the word at pos, 0 if pos is out of range.
+ * The word is padded with spaces, so positions right after the end read as ' '.
+ * @param pos int
+ * @return byte
+ */
+func (d *doubleMetaphone) at(pos int) byte {
+	if pos < 0 || pos >= len(d.word) {
+		return 0
+	}
+	return d.word[pos]
+}
+
+/*
+ * stringAt is a helper function to check if the substring of length size at start is one of options.
+ * @param start int
+ * @param size int
+ * @param options ...string
+ * @return bool
+ */
+func (d *doubleMetaphone) stringAt(start, size int, options ...string) bool {
+	if start < 0 || start+size > len(d.word) {
+		return false
+	}
+	sub := d.word[start : start+size]
+	for _, option := range options {
+		if sub == option {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+ * isVowel is a helper function to check if the byte at pos is a vowel, Y included.
+ * @param pos int
+ * @return bool
+ */
+func (d *doubleMetaphone) isVowel(pos int) bool {
+	c := d.at(pos)
+	return isPhoneticVowel(c) || c == 'Y'
+}
+
+/*
+ * add is a helper function to append codes to the keys, the first one to the primary key and
+ * the last one to the alternate key.
+ * @param codes ...string
+ */
+func (d *doubleMetaphone) add(codes ...string) {
+	d.primary.WriteString(codes[0])
+	d.alternate.WriteString(codes[len(codes)-1])
+}
+
+/*
+ * DoubleMetaphone returns the primary and alternate Double Metaphone keys of input, as described
+ * by Lawrence Philips. Both keys are truncated to four characters, when the word has a single
+ * pronunciation both keys are equal.
+ * @param input string
+ * @return string primary key
+ * @return string alternate key
+ * Example: DoubleMetaphone("Smith") => "SM0", "XMT", DoubleMetaphone("Schmidt") => "XMT", "SMT"
+ */
+func DoubleMetaphone(input string) (string, string) {
+	word := phoneticInput(input, true)
+	if word == "" {
+		return "", ""
+	}
+	d := &doubleMetaphone{
+		word:   word + "     ",
+		length: len(word),
+		slavoGermanic: strings.Contains(word, "W") || strings.Contains(word, "K") ||
+			strings.Contains(word, "CZ") || strings.Contains(word, "WITZ"),
+	}
+	last := d.length - 1
+	current := 0
+
+	// skip these when at start of word
+	if d.stringAt(0, 2, "GN", "KN", "PN", "WR", "PS") {
+		current++
+	}
+	// initial X is pronounced Z, which maps to S, e.g. Xavier
+	if d.at(0) == 'X' {
+		d.add("S")
+		current++
+	}
+
+	for (d.primary.Len() < 4 || d.alternate.Len() < 4) && current < d.length {
+		switch d.at(current) {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			// all initial vowels map to A
+			if current == 0 {
+				d.add("A")
+			}
+			current++
+		case 'B':
+			// -mb, e.g. dumb, is handled under M
+			d.add("P")
+			current += d.skip(current, 'B')
+		case 'C':
+			current = d.encodeC(current)
+		case 'D':
+			switch {
+			case d.stringAt(current, 2, "DG") && d.stringAt(current+2, 1, "I", "E", "Y"):
+				// e.g. edge
+				d.add("J")
+				current += 3
+			case d.stringAt(current, 2, "DG"):
+				// e.g. edgar
+				d.add("TK")
+				current += 2
+			case d.stringAt(current, 2, "DT", "DD"):
+				d.add("T")
+				current += 2
+			default:
+				d.add("T")
+				current++
+			}
+		case 'F':
+			d.add("F")
+			current += d.skip(current, 'F')
+		case 'G':
+			current = d.encodeG(current)
+		case 'H':
+			// only keep if first and before vowel or between two vowels
+			if (current == 0 || d.isVowel(current-1)) && d.isVowel(current+1) {
+				d.add("H")
+				current += 2
+			} else {
+				current++
+			}
+		case 'J':
+			current = d.encodeJ(current, last)
+		case 'K':
+			d.add("K")
+			current += d.skip(current, 'K')
+		case 'L':
+			if d.at(current+1) == 'L' {
+				// spanish, e.g. cabrillo, gallegos
+				if (current == d.length-3 && d.stringAt(current-1, 4, "ILLO", "ILLA", "ALLE")) ||
+					((d.stringAt(last-1, 2, "AS", "OS") || d.stringAt(last, 1, "A", "O")) && d.stringAt(current-1, 4, "ALLE")) {
+					d.add("L", "")
+					current += 2
+					break
+				}
+				current++
+			}
+			d.add("L")
+			current++
+		case 'M':
+			d.add("M")
+			if (d.stringAt(current-1, 3, "UMB") && (current+1 == last || d.stringAt(current+2, 2, "ER"))) ||
+				d.at(current+1) == 'M' {
+				current += 2
+			} else {
+				current++
+			}
+		case 'N':
+			d.add("N")
+			current += d.skip(current, 'N')
+		case 'P':
+			if d.at(current+1) == 'H' {
+				d.add("F")
+				current += 2
+				break
+			}
+			// also account for campbell and raspberry
+			d.add("P")
+			if d.stringAt(current+1, 1, "P", "B") {
+				current += 2
+			} else {
+				current++
+			}
+		case 'Q':
+			d.add("K")
+			current += d.skip(current, 'Q')
+		case 'R':
+			// french, e.g. rogier, but exclude hochmeier
+			if current == last && !d.slavoGermanic && d.stringAt(current-2, 2, "IE") && !d.stringAt(current-4, 2, "ME", "MA") {
+				d.add("", "R")
+			} else {
+				d.add("R")
+			}
+			current += d.skip(current, 'R')
+		case 'S':
+			current = d.encodeS(current, last)
+		case 'T':
+			switch {
+			case d.stringAt(current, 4, "TION"), d.stringAt(current, 3, "TIA", "TCH"):
+				d.add("X")
+				current += 3
+			case d.stringAt(current, 2, "TH"), d.stringAt(current, 3, "TTH"):
+				// special case thomas, thames or germanic
+				if d.stringAt(current+2, 2, "OM", "AM") || d.stringAt(0, 4, "VAN ", "VON ") || d.stringAt(0, 3, "SCH") {
+					d.add("T")
+				} else {
+					d.add("0", "T")
+				}
+				current += 2
+			default:
+				d.add("T")
+				if d.stringAt(current+1, 1, "T", "D") {
+					current += 2
+				} else {
+					current++
+				}
+			}
+		case 'V':
+			d.add("F")
+			current += d.skip(current, 'V')
+		case 'W':
+			current = d.encodeW(current, last)
+		case 'X':
+			// french, e.g. breaux
+			if !(current == last && (d.stringAt(current-3, 3, "IAU", "EAU") || d.stringAt(current-2, 2, "AU", "OU"))) {
+				d.add("KS")
+			}
+			if d.stringAt(current+1, 1, "C", "X") {
+				current += 2
+			} else {
+				current++
+			}
+		case 'Z':
+			// chinese pinyin, e.g. zhao
+			if d.at(current+1) == 'H' {
+				d.add("J")
+				current += 2
+				break
+			}
+			if d.stringAt(current+1, 2, "ZO", "ZI", "ZA") || (d.slavoGermanic && current > 0 && d.at(current-1) != 'T') {
+				d.add("S", "TS")
+			} else {
+				d.add("S")
+			}
+			current += d.skip(current, 'Z')
+		default:
+			current++
+		}
+	}
+
+	primary, alternate := d.primary.String(), d.alternate.String()
+	if len(primary) > 4 {
+		primary = primary[:4]
+	}
+	if len(alternate) > 4 {
+		alternate = alternate[:4]
+	}
+	return primary, alternate
+}
+
+/*
+ * skip is a helper function to get the number of positions to advance past the letter c at
+ * current, 2 when it is doubled.
+ * @param current int
+ * @param c byte
+ * @return int
+ */
+func (d *doubleMetaphone) skip(current int, c byte) int {
+	if d.at(current+1) == c {
+		return 2
+	}
+	return 1
+}
+
+/*
+ * encodeC is a helper function to encode the letter C at current.
+ * @param current int
+ * @return int next position
+ */
+func (d *doubleMetaphone) encodeC(current int) int {
+	// various germanic
+	if current > 1 && !d.isVowel(current-2) && d.stringAt(current-1, 3, "ACH") &&
+		d.at(current+2) != 'I' && (d.at(current+2) != 'E' || d.stringAt(current-2, 6, "BACHER", "MACHER")) {
+		d.add("K")
+		return current + 2
+	}
+	// special case caesar
+	if current == 0 && d.stringAt(current, 6, "CAESAR") {
+		d.add("S")
+		return current + 2
+	}
+	// italian chianti
+	if d.stringAt(current, 4, "CHIA") {
+		d.add("K")
+		return current + 2
+	}
+	if d.stringAt(current, 2, "CH") {
+		// find michael
+		if current > 0 && d.stringAt(current, 4, "CHAE") {
+			d.add("K", "X")
+			return current + 2
+		}
+		// greek roots, e.g. chemistry, chorus
+		if current == 0 && (d.stringAt(current+1, 5, "HARAC", "HARIS") || d.stringAt(current+1, 3, "HOR", "HYM", "HIA", "HEM")) &&
+			!d.stringAt(0, 5, "CHORE") {
+			d.add("K")
+			return current + 2
+		}
+		// germanic, greek, or otherwise CH for KH sound
+		if d.stringAt(0, 4, "VAN ", "VON ") || d.stringAt(0, 3, "SCH") ||
+			d.stringAt(current-2, 6, "ORCHES", "ARCHIT", "ORCHID") || d.stringAt(current+2, 1, "T", "S") ||
+			((d.stringAt(current-1, 1, "A", "O", "U", "E") || current == 0) &&
+				d.stringAt(current+2, 1, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ")) {
+			d.add("K")
+		} else if current > 0 {
+			if d.stringAt(0, 2, "MC") {
+				// e.g. mchugh
+				d.add("K")
+			} else {
+				d.add("X", "K")
+			}
+		} else {
+			d.add("X")
+		}
+		return current + 2
+	}
+	// e.g. czerny
+	if d.stringAt(current, 2, "CZ") && !d.stringAt(current-2, 4, "WICZ") {
+		d.add("S", "X")
+		return current + 2
+	}
+	// e.g. focaccia
+	if d.stringAt(current+1, 3, "CIA") {
+		d.add("X")
+		return current + 3
+	}
+	// double C, but not if e.g. mcclellan
+	if d.stringAt(current, 2, "CC") && !(current == 1 && d.at(0) == 'M') {
+		// bellocchio but not bacchus
+		if d.stringAt(current+2, 1, "I", "E", "H") && !d.stringAt(current+2, 2, "HU") {
+			if (current == 1 && d.at(current-1) == 'A') || d.stringAt(current-1, 5, "UCCEE", "UCCES") {
+				// e.g. accident, accede, succeed
+				d.add("KS")
+			} else {
+				// e.g. bacci, bertucci
+				d.add("X")
+			}
+			return current + 3
+		}
+		// Pierce's rule
+		d.add("K")
+		return current + 2
+	}
+	if d.stringAt(current, 2, "CK", "CG", "CQ") {
+		d.add("K")
+		return current + 2
+	}
+	if d.stringAt(current, 2, "CI", "CE", "CY") {
+		// italian vs. english
+		if d.stringAt(current, 3, "CIO", "CIE", "CIA") {
+			d.add("S", "X")
+		} else {
+			d.add("S")
+		}
+		return current + 2
+	}
+	d.add("K")
+	// e.g. mac caffrey, mac gregor
+	if d.stringAt(current+1, 2, " C", " Q", " G") {
+		return current + 3
+	}
+	if d.stringAt(current+1, 1, "C", "K", "Q") && !d.stringAt(current+1, 2, "CE", "CI") {
+		return current + 2
+	}
+	return current + 1
+}
+
+/*
+ * encodeG is a helper function to encode the letter G at current.
+ * @param current int
+ * @return int next position
+ */
+func (d *doubleMetaphone) encodeG(current int) int {
+	if d.at(current+1) == 'H' {
+		if current > 0 && !d.isVowel(current-1) {
+			d.add("K")
+			return current + 2
+		}
+		// e.g. ghislane, ghiradelli
+		if current == 0 {
+			if d.at(current+2) == 'I' {
+				d.add("J")
+			} else {
+				d.add("K")
+			}
+			return current + 2
+		}
+		// Parker's rule, e.g. hugh
+		if (current > 1 && d.stringAt(current-2, 1, "B", "H", "D")) ||
+			(current > 2 && d.stringAt(current-3, 1, "B", "H", "D")) ||
+			(current > 3 && d.stringAt(current-4, 1, "B", "H")) {
+			return current + 2
+		}
+		if current > 2 && d.at(current-1) == 'U' && d.stringAt(current-3, 1, "C", "G", "L", "R", "T") {
+			// e.g. laugh, mclaughlin, cough, rough, tough
+			d.add("F")
+		} else if current > 0 && d.at(current-1) != 'I' {
+			d.add("K")
+		}
+		return current + 2
+	}
+	if d.at(current+1) == 'N' {
+		if current == 1 && d.isVowel(0) && !d.slavoGermanic {
+			d.add("KN", "N")
+		} else if !d.stringAt(current+2, 2, "EY") && d.at(current+1) != 'Y' && !d.slavoGermanic {
+			// not e.g. cagney
+			d.add("N", "KN")
+		} else {
+			d.add("KN")
+		}
+		return current + 2
+	}
+	// e.g. tagliaro
+	if d.stringAt(current+1, 2, "LI") && !d.slavoGermanic {
+		d.add("KL", "L")
+		return current + 2
+	}
+	// -ges-, -gep-, -gel-, -gie- at beginning
+	if current == 0 && (d.at(current+1) == 'Y' ||
+		d.stringAt(current+1, 2, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")) {
+		d.add("K", "J")
+		return current + 2
+	}
+	// -ger-, -gy-
+	if (d.stringAt(current+1, 2, "ER") || d.at(current+1) == 'Y') && !d.stringAt(0, 6, "DANGER", "RANGER", "MANGER") &&
+		!d.stringAt(current-1, 1, "E", "I") && !d.stringAt(current-1, 3, "RGY", "OGY") {
+		d.add("K", "J")
+		return current + 2
+	}
+	// italian, e.g. biaggi
+	if d.stringAt(current+1, 1, "E", "I", "Y") || d.stringAt(current-1, 4, "AGGI", "OGGI") {
+		if d.stringAt(0, 4, "VAN ", "VON ") || d.stringAt(0, 3, "SCH") || d.stringAt(current+1, 2, "ET") {
+			// obvious germanic
+			d.add("K")
+		} else if d.stringAt(current+1, 4, "IER ") {
+			// always soft if french ending
+			d.add("J")
+		} else {
+			d.add("J", "K")
+		}
+		return current + 2
+	}
+	d.add("K")
+	return current + d.skip(current, 'G')
+}
+
+/*
+ * encodeJ is a helper function to encode the letter J at current.
+ * @param current int
+ * @param last int
+ * @return int next position
+ */
+func (d *doubleMetaphone) encodeJ(current, last int) int {
+	// obvious spanish, e.g. jose, san jacinto
+	if d.stringAt(current, 4, "JOSE") || d.stringAt(0, 4, "SAN ") {
+		if (current == 0 && d.at(current+4) == ' ') || d.stringAt(0, 4, "SAN ") {
+			d.add("H")
+		} else {
+			d.add("J", "H")
+		}
+		return current + 1
+	}
+	switch {
+	case current == 0:
+		// e.g. yankelovich, jankelowicz
+		d.add("J", "A")
+	case d.isVowel(current-1) && !d.slavoGermanic && (d.at(current+1) == 'A' || d.at(current+1) == 'O'):
+		// spanish pronunciation of e.g. bajador
+		d.add("J", "H")
+	case current == last:
+		d.add("J", "")
+	case !d.stringAt(current+1, 1, "L", "T", "K", "S", "N", "M", "B", "Z") && !d.stringAt(current-1, 1, "S", "K", "L"):
+		d.add("J")
+	}
+	return current + d.skip(current, 'J')
+}
+
+/*
+ * encodeS is a helper function to encode the letter S at current.
+ * @param current int
+ * @param last int
+ * @return int next position
+ */
+func (d *doubleMetaphone) encodeS(current, last int) int {
+	// special cases island, isle, carlisle, carlysle
+	if d.stringAt(current-1, 3, "ISL", "YSL") {
+		return current + 1
+	}
+	// special case sugar-
+	if current == 0 && d.stringAt(current, 5, "SUGAR") {
+		d.add("X", "S")
+		return current + 1
+	}
+	if d.stringAt(current, 2, "SH") {
+		if d.stringAt(current+1, 4, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			// germanic
+			d.add("S")
+		} else {
+			d.add("X")
+		}
+		return current + 2
+	}
+	// italian and armenian
+	if d.stringAt(current, 3, "SIO", "SIA") || d.stringAt(current, 4, "SIAN") {
+		if d.slavoGermanic {
+			d.add("S")
+		} else {
+			d.add("S", "X")
+		}
+		return current + 3
+	}
+	// german and anglicisations, e.g. smith matches schmidt, snider matches schneider,
+	// also -sz- in slavic languages although in hungarian it is pronounced S
+	if (current == 0 && d.stringAt(current+1, 1, "M", "N", "L", "W")) || d.stringAt(current+1, 1, "Z") {
+		d.add("S", "X")
+		return current + d.skip(current, 'Z')
+	}
+	if d.stringAt(current, 2, "SC") {
+		// Schlesinger's rule
+		if d.at(current+2) == 'H' {
+			// dutch origin, e.g. school, schooner
+			if d.stringAt(current+3, 2, "OO", "ER", "EN", "UY", "ED", "EM") {
+				if d.stringAt(current+3, 2, "ER", "EN") {
+					// e.g. schermerhorn, schenker
+					d.add("X", "SK")
+				} else {
+					d.add("SK")
+				}
+			} else if current == 0 && !d.isVowel(3) && d.at(3) != 'W' {
+				d.add("X", "S")
+			} else {
+				d.add("X")
+			}
+			return current + 3
+		}
+		if d.stringAt(current+2, 1, "I", "E", "Y") {
+			d.add("S")
+		} else {
+			d.add("SK")
+		}
+		return current + 3
+	}
+	// french, e.g. resnais, artois
+	if current == last && d.stringAt(current-2, 2, "AI", "OI") {
+		d.add("", "S")
+	} else {
+		d.add("S")
+	}
+	if d.stringAt(current+1, 1, "S", "Z") {
+		return current + 2
+	}
+	return current + 1
+}
+
+/*
+ * encodeW is a helper function to encode the letter W at current.
+ * @param current int
+ * @param last int
+ * @return int next position
+ */
+func (d *doubleMetaphone) encodeW(current, last int) int {
+	// can also be in the middle of a word
+	if d.stringAt(current, 2, "WR") {
+		d.add("R")
+		return current + 2
+	}
+	if current == 0 && (d.isVowel(current+1) || d.stringAt(current, 2, "WH")) {
+		if d.isVowel(current + 1) {
+			// wasserman should match vasserman
+			d.add("A", "F")
+		} else {
+			// need uomo to match womo
+			d.add("A")
+		}
+	}
+	// arnow should match arnoff
+	if (current == last && d.isVowel(current-1)) || d.stringAt(current-1, 5, "EWSKI", "EWSKY", "OWSKI", "OWSKY") ||
+		d.stringAt(0, 3, "SCH") {
+		d.add("", "F")
+		return current + 1
+	}
+	// polish, e.g. filipowicz
+	if d.stringAt(current, 4, "WICZ", "WITZ") {
+		d.add("TS", "FX")
+		return current + 4
+	}
+	return current + 1
+}
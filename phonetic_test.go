@@ -0,0 +1,145 @@
+package stringy
+
+import "testing"
+
+// Test Soundex with the vectors of the National Archives description
+func TestSoundex(t *testing.T) {
+	testCases := []struct {
+		input, expected string
+	}{
+		{"Robert", "R163"},
+		{"Rupert", "R163"},
+		{"Rubin", "R150"},
+		{"Ashcraft", "A261"},
+		{"Ashcroft", "A261"},
+		{"Tymczak", "T522"},
+		{"Pfister", "P236"},
+		{"Honeyman", "H555"},
+		{"Lee", "L000"},
+		{"Jackson", "J250"},
+		{"O'Brien", "O165"},
+		{"Müller", "M460"},
+		{"", ""},
+		{"123", ""},
+	}
+	for _, tc := range testCases {
+		if val := Soundex(tc.input); val != tc.expected {
+			t.Errorf("Soundex(%q) - Expected: %s but got: %s", tc.input, tc.expected, val)
+		}
+	}
+}
+
+// Test Metaphone
+func TestMetaphone(t *testing.T) {
+	testCases := []struct {
+		input, expected string
+	}{
+		{"howl", "HL"},
+		{"The", "0"},
+		{"quick", "KK"},
+		{"brown", "BRN"},
+		{"fox", "FKS"},
+		{"jumped", "JMPT"},
+		{"over", "OFR"},
+		{"lazy", "LS"},
+		{"dogs", "TKS"},
+		{"Thumb", "0M"},
+		{"Knight", "NT"},
+		{"Wright", "RT"},
+		{"Whistle", "WSTL"},
+		{"Science", "SNS"},
+		{"Edge", "EJ"},
+		{"Xavier", "SFR"},
+		{"Müller", "MLR"},
+		{"", ""},
+	}
+	for _, tc := range testCases {
+		if val := Metaphone(tc.input); val != tc.expected {
+			t.Errorf("Metaphone(%q) - Expected: %s but got: %s", tc.input, tc.expected, val)
+		}
+	}
+}
+
+// Test Double Metaphone with the examples of the original publication
+func TestDoubleMetaphone(t *testing.T) {
+	testCases := []struct {
+		input, primary, alternate string
+	}{
+		{"Smith", "SM0", "XMT"},
+		{"Schmidt", "XMT", "SMT"},
+		{"Jose", "HS", "HS"},
+		{"Thumb", "0M", "TM"},
+		{"Catherine", "K0RN", "KTRN"},
+		{"Katherine", "K0RN", "KTRN"},
+		{"Aubrey", "APR", "APR"},
+		{"Richard", "RXRT", "RKRT"},
+		{"Filipowitz", "FLPT", "FLPF"},
+		{"Arnow", "ARN", "ARNF"},
+		{"Arnoff", "ARNF", "ARNF"},
+		{"Dumb", "TM", "TM"},
+		{"Campbell", "KMPL", "KMPL"},
+		{"Caesar", "SSR", "SSR"},
+		{"Chianti", "KNT", "KNT"},
+		{"Michael", "MKL", "MXL"},
+		{"Jankelowicz", "JNKL", "ANKL"},
+		{"Xavier", "SF", "SFR"},
+		{"Zhao", "J", "J"},
+		{"Wasserman", "ASRM", "FSRM"},
+		{"Jürgen", "JRJN", "ARKN"},
+		{"", "", ""},
+	}
+	for _, tc := range testCases {
+		primary, alternate := DoubleMetaphone(tc.input)
+		if primary != tc.primary || alternate != tc.alternate {
+			t.Errorf("DoubleMetaphone(%q) - Expected: %s, %s but got: %s, %s", tc.input, tc.primary, tc.alternate, primary, alternate)
+		}
+	}
+}
+
+// Test NYSIIS
+func TestNYSIIS(t *testing.T) {
+	testCases := []struct {
+		input, expected string
+	}{
+		{"Macintosh", "MCANT"},
+		{"Knuth", "NAT"},
+		{"Bishop", "BASAP"},
+		{"Carlson", "CARLSAN"},
+		{"Larson", "LARSAN"},
+		{"Morrison", "MARASAN"},
+		{"Worthy", "WARTY"},
+		{"Ogata", "OGAT"},
+		{"Montgomery", "MANTGANARY"},
+		{"Mcknight", "MCNAGT"},
+		{"Phillipson", "FALAPSAN"},
+		{"Brown", "BRAN"},
+		{"Schmidt", "SNAD"},
+		{"Gößmann", "GASNAN"},
+		{"", ""},
+	}
+	for _, tc := range testCases {
+		if val := NYSIIS(tc.input); val != tc.expected {
+			t.Errorf("NYSIIS(%q) - Expected: %s but got: %s", tc.input, tc.expected, val)
+		}
+	}
+}
+
+// Test phonetic keys on a chain
+func TestInput_Phonetic(t *testing.T) {
+	str := New("  Schmidt ").Trim()
+	if val := str.Soundex(); val != "S530" {
+		t.Errorf("Expected: S530 but got: %s", val)
+	}
+	if val := str.Metaphone(); val != "SKMTT" {
+		t.Errorf("Expected: SKMTT but got: %s", val)
+	}
+	if primary, alternate := str.DoubleMetaphone(); primary != "XMT" || alternate != "SMT" {
+		t.Errorf("Expected: XMT, SMT but got: %s, %s", primary, alternate)
+	}
+	if val := str.NYSIIS(); val != "SNAD" {
+		t.Errorf("Expected: SNAD but got: %s", val)
+	}
+	if New("Smith").Soundex() != New("Smyth").Soundex() {
+		t.Errorf("Expected Smith and Smyth to share a Soundex code")
+	}
+}
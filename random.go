@@ -0,0 +1,163 @@
+package stringy
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"strings"
+	"sync"
+)
+
+// Charsets for Random and Generator.String
+const (
+	// CharsetAlphanumeric holds ASCII letters and digits
+	CharsetAlphanumeric = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	// CharsetHex holds lowercase hexadecimal digits
+	CharsetHex = "0123456789abcdef"
+	// CharsetCrockford holds the Crockford base32 alphabet, without I, L, O and U
+	CharsetCrockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// CharsetURLSafe holds the characters of the URL safe base64 alphabet
+	CharsetURLSafe = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	// CharsetNoLookalikes holds letters and digits without the easily confused 0, O, o, 1, I, i, l
+	CharsetNoLookalikes = "23456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghjkmnpqrstuvwxyz"
+)
+
+/*
+ * Generator generates random strings from a source of random bytes. Characters are sampled
+ * without modulo bias. A Generator is safe for concurrent use.
+ * Example: NewSeededGenerator(42).String(8, CharsetHex) returns the same value on every run
+ */
+type Generator struct {
+	mu  sync.Mutex
+	src io.Reader
+}
+
+// defaultGenerator reads from crypto/rand and is used by Random
+var defaultGenerator = NewGenerator(nil)
+
+/*
+ * NewGenerator creates a Generator reading random bytes from src, crypto/rand if src is nil.
+ * @param src io.Reader
+ * @return *Generator
+ */
+func NewGenerator(src io.Reader) *Generator {
+	if src == nil {
+		src = crand.Reader
+	}
+	return &Generator{src: src}
+}
+
+/*
+ * NewSeededGenerator creates a deterministic Generator producing the same strings for the same
+ * seed, which makes tests reproducible. It is not suitable for secrets.
+ * @param seed int64
+ * @return *Generator
+ */
+func NewSeededGenerator(seed int64) *Generator {
+	return NewGenerator(rand.New(rand.NewSource(seed)))
+}
+
+/*
+ * String returns a string of length characters sampled uniformly from charset. Charset is
+ * read as runes, characters listed twice are twice as likely.
+ * @param length int number of characters
+ * @param charset string
+ * @return string
+ * @return error ErrNegativeLength, ErrEmptyCharset or the error of the source wrapped in *OpError
+ * Example: NewGenerator(nil).String(16, CharsetURLSafe) => "q3Zk-V0dR_x8LwT1"
+ */
+func (g *Generator) String(length int, charset string) (string, error) {
+	if length < 0 {
+		return "", newOpError("Random", "", length, ErrNegativeLength)
+	}
+	chars := []rune(charset)
+	if len(chars) == 0 {
+		return "", newOpError("Random", "", charset, ErrEmptyCharset)
+	}
+
+	// values at or above limit are rejected, the accepted range is a multiple of len(chars)
+	n := uint64(len(chars))
+	limit := (1 << 32) - (1<<32)%n
+	result := make([]rune, 0, length)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for len(result) < length {
+		buf := make([]byte, 4*(length-len(result)))
+		if _, err := io.ReadFull(g.src, buf); err != nil {
+			return "", newOpError("Random", "", length, err)
+		}
+		for idx := 0; idx < len(buf); idx += 4 {
+			if v := uint64(binary.BigEndian.Uint32(buf[idx:])); v < limit {
+				result = append(result, chars[v%n])
+			}
+		}
+	}
+	return string(result), nil
+}
+
+/*
+ * Random returns a string of length characters sampled uniformly from charset using crypto/rand,
+ * suitable for tokens and passwords. Use NewSeededGenerator for reproducible strings in tests.
+ * @param length int number of characters
+ * @param charset string e.g. CharsetAlphanumeric
+ * @return string
+ * @return error ErrNegativeLength, ErrEmptyCharset or the error of crypto/rand wrapped in *OpError
+ * Example: Random(8, CharsetCrockford) => "7ZK3QH0M"
+ */
+func Random(length int, charset string) (string, error) {
+	return defaultGenerator.String(length, charset)
+}
+
+/*
+* ShuffleWith shuffles the characters (grapheme clusters) of the input using r, so the result is reproducible
+* for a seeded r. A nil r behaves like Shuffle.
+* It can be chained on function which return StringManipulation interface.
+* @param r *rand.Rand
+* @return string
+* Example: "hello" => ShuffleWith(rand.New(rand.NewSource(1))) => the same permutation on every run
+ */
+func (i *input) ShuffleWith(r *rand.Rand) string {
+	if i.err != nil {
+		return ""
+	}
+	if r == nil {
+		return i.Shuffle()
+	}
+	return shuffleGraphemes(getInput(*i), r)
+}
+
+/*
+ * shuffleGraphemes is a helper function to shuffle the grapheme clusters of input with r.
+ * @param input string
+ * @param r *rand.Rand
+ * @return string
+ */
+func shuffleGraphemes(input string, r *rand.Rand) string {
+	clusters := graphemes(input)
+	r.Shuffle(len(clusters), func(i, j int) {
+		clusters[i], clusters[j] = clusters[j], clusters[i]
+	})
+	return strings.Join(clusters, "")
+}
+
+// cryptoSource is a rand.Source64 reading from crypto/rand, it is safe for concurrent use
+type cryptoSource struct{}
+
+// Seed does nothing, a crypto source can not be seeded
+func (cryptoSource) Seed(int64) {}
+
+// Int63 returns a non-negative random int64
+func (s cryptoSource) Int63() int64 {
+	return int64(s.Uint64() &^ (1 << 63))
+}
+
+// Uint64 returns a random uint64, it panics if crypto/rand fails as there is no safe fallback
+func (cryptoSource) Uint64() uint64 {
+	var buf [8]byte
+	if _, err := io.ReadFull(crand.Reader, buf[:]); err != nil {
+		panic(err)
+	}
+	return binary.BigEndian.Uint64(buf[:])
+}
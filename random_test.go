@@ -0,0 +1,86 @@
+package stringy
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// Test Random with the predefined charsets
+func TestRandom(t *testing.T) {
+	charsets := []string{CharsetAlphanumeric, CharsetHex, CharsetCrockford, CharsetURLSafe, CharsetNoLookalikes, "äöü"}
+	for _, charset := range charsets {
+		val, err := Random(32, charset)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if n := len([]rune(val)); n != 32 {
+			t.Errorf("Expected 32 characters but got: %d", n)
+		}
+		for _, r := range val {
+			if !strings.ContainsRune(charset, r) {
+				t.Errorf("Expected %q to be part of %q", r, charset)
+			}
+		}
+	}
+	if val, err := Random(0, CharsetHex); val != "" || err != nil {
+		t.Errorf("Expected empty string but got: %q, %v", val, err)
+	}
+	for _, c := range "0OoIil1" {
+		if strings.ContainsRune(CharsetNoLookalikes, c) {
+			t.Errorf("Expected %q not to be part of CharsetNoLookalikes", c)
+		}
+	}
+}
+
+// Test Random reports invalid arguments
+func TestRandom_Error(t *testing.T) {
+	if _, err := Random(-1, CharsetHex); !errors.Is(err, ErrNegativeLength) {
+		t.Errorf("Expected ErrNegativeLength but got: %v", err)
+	}
+	if _, err := Random(8, ""); !errors.Is(err, ErrEmptyCharset) {
+		t.Errorf("Expected ErrEmptyCharset but got: %v", err)
+	}
+	failing := NewGenerator(iotest.TimeoutReader(strings.NewReader("")))
+	if _, err := failing.String(8, CharsetHex); err == nil {
+		t.Errorf("Expected the error of the source")
+	}
+}
+
+// Test seeded generators are deterministic and sampling is not biased
+func TestGenerator_Seeded(t *testing.T) {
+	a, _ := NewSeededGenerator(42).String(64, CharsetCrockford)
+	b, _ := NewSeededGenerator(42).String(64, CharsetCrockford)
+	c, _ := NewSeededGenerator(43).String(64, CharsetCrockford)
+	if a != b {
+		t.Errorf("Expected the same string for the same seed but got: %s and %s", a, b)
+	}
+	if a == c {
+		t.Errorf("Expected different strings for different seeds")
+	}
+
+	// with 3 characters a biased modulo would favour the first one
+	val, _ := NewSeededGenerator(7).String(30000, "abc")
+	for _, c := range "abc" {
+		if n := strings.Count(val, string(c)); n < 9500 || n > 10500 {
+			t.Errorf("Expected about 10000 %q but got: %d", c, n)
+		}
+	}
+}
+
+// Test ShuffleWith is reproducible
+func TestInput_ShuffleWith(t *testing.T) {
+	a := New("hello world").ShuffleWith(rand.New(rand.NewSource(1)))
+	b := New("hello world").ShuffleWith(rand.New(rand.NewSource(1)))
+	if a != b {
+		t.Errorf("Expected the same permutation for the same seed but got: %s and %s", a, b)
+	}
+	if len(a) != len("hello world") || strings.Count(a, "l") != 3 {
+		t.Errorf("Expected a permutation of the input but got: %s", a)
+	}
+	if val := New("aaa").ShuffleWith(nil); val != "aaa" {
+		t.Errorf("Expected: aaa but got: %s", val)
+	}
+}
@@ -0,0 +1,398 @@
+package stringy
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PIIKind identifies a kind of personally identifiable information detected by a Redactor
+type PIIKind int
+
+// const below are the kinds of PII a Redactor detects, in detection order
+const (
+	PIIToken PIIKind = iota
+	PIIEmail
+	PIICard
+	PIIPhone
+)
+
+// String returns "token", "email", "card" or "phone"
+func (k PIIKind) String() string {
+	switch k {
+	case PIIToken:
+		return "token"
+	case PIIEmail:
+		return "email"
+	case PIICard:
+		return "card"
+	case PIIPhone:
+		return "phone"
+	default:
+		return "unknown"
+	}
+}
+
+// piiKinds holds all kinds in detection order
+var piiKinds = []PIIKind{PIIToken, PIIEmail, PIICard, PIIPhone}
+
+var (
+	// bearerRegexp matches bearer tokens, captured by the first group so the keyword is kept
+	bearerRegexp = regexp.MustCompile(`(?i:bearer)\s+([A-Za-z0-9._~+/-]{8,}=*)`)
+	// tokenRegexp matches JWTs and API keys with well known prefixes
+	tokenRegexp = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+|` +
+		`(?:sk|pk|rk)_(?:live|test)_[A-Za-z0-9]{8,}|gh[pousr]_[A-Za-z0-9]{20,}|AKIA[0-9A-Z]{16}|xox[abprs]-[A-Za-z0-9-]{10,}`)
+	emailRegexp = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+	cardRegexp  = regexp.MustCompile(`\d(?:[ -]?\d){12,18}`)
+	phoneRegexp = regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{1,4}\)[ .-]?)?\d{2,5}(?:[ .-]\d{2,5}){1,4}|\+\d{7,15}`)
+	dateRegexp  = regexp.MustCompile(`^(?:\d{4}[./-]\d{1,2}[./-]\d{1,2}|\d{1,2}[./-]\d{1,2}[./-]\d{4})$`)
+)
+
+/*
+ * Redactor detects PII in free text and replaces it. By default emails are masked except
+ * for the first character of the local part, card numbers passing the Luhn check except
+ * for the last four digits, phone numbers except for the last two digits, and tokens are
+ * replaced with "[REDACTED]". A Redactor is immutable once created and safe for concurrent use.
+ * Example: NewRedactor().Redact("mail john@example.com") => "mail j***@example.com"
+ */
+type Redactor struct {
+	kinds     map[PIIKind]bool
+	replacers map[PIIKind]func(match string) string
+}
+
+// DefaultRedactor detects every kind of PII and is used by the Redact chain method
+var DefaultRedactor = NewRedactor()
+
+/*
+ * NewRedactor creates a Redactor detecting the given kinds of PII, all kinds if none are passed.
+ * @param kinds ...PIIKind
+ * @return *Redactor
+ * Example: NewRedactor(PIIEmail, PIICard)
+ */
+func NewRedactor(kinds ...PIIKind) *Redactor {
+	if len(kinds) == 0 {
+		kinds = piiKinds
+	}
+	r := &Redactor{
+		kinds: make(map[PIIKind]bool, len(kinds)),
+		replacers: map[PIIKind]func(string) string{
+			PIIToken: func(string) string { return "[REDACTED]" },
+			PIIEmail: maskEmail,
+			PIICard: func(match string) string {
+				return maskDigits(match, 4)
+			},
+			PIIPhone: func(match string) string {
+				return maskDigits(match, 2)
+			},
+		},
+	}
+	for _, kind := range kinds {
+		r.kinds[kind] = true
+	}
+	return r
+}
+
+/*
+ * WithPlaceholder returns a copy of the redactor replacing every match of kind with placeholder.
+ * @param kind PIIKind
+ * @param placeholder string
+ * @return *Redactor
+ * Example: NewRedactor().WithPlaceholder(PIIEmail, "[EMAIL]").Redact("a@b.io") => "[EMAIL]"
+ */
+func (r *Redactor) WithPlaceholder(kind PIIKind, placeholder string) *Redactor {
+	return r.WithReplacer(kind, func(string) string {
+		return placeholder
+	})
+}
+
+/*
+ * WithReplacer returns a copy of the redactor replacing every match of kind with the result of replace.
+ * @param kind PIIKind
+ * @param replace func(match string) string
+ * @return *Redactor
+ */
+func (r *Redactor) WithReplacer(kind PIIKind, replace func(match string) string) *Redactor {
+	c := &Redactor{
+		kinds:     r.kinds,
+		replacers: make(map[PIIKind]func(string) string, len(r.replacers)),
+	}
+	for k, v := range r.replacers {
+		c.replacers[k] = v
+	}
+	c.replacers[kind] = replace
+	return c
+}
+
+/*
+ * Redact returns text with all detected PII replaced.
+ * @param text string
+ * @return string
+ * Example: DefaultRedactor.Redact("card 4111 1111 1111 1111") => "card **** **** **** 1111"
+ */
+func (r *Redactor) Redact(text string) string {
+	for _, kind := range piiKinds {
+		if !r.kinds[kind] {
+			continue
+		}
+		switch kind {
+		case PIIToken:
+			text = replaceMatches(text, bearerRegexp, bearerToken, r.replacers[kind])
+			text = replaceMatches(text, tokenRegexp, nil, r.replacers[kind])
+		case PIIEmail:
+			text = replaceMatches(text, emailRegexp, nil, r.replacers[kind])
+		case PIICard:
+			text = replaceMatches(text, cardRegexp, luhnPrefix, r.replacers[kind])
+		case PIIPhone:
+			text = replaceMatches(text, phoneRegexp, phoneNumber, r.replacers[kind])
+		}
+	}
+	return text
+}
+
+/*
+ * Reader returns a reader redacting src line by line, PII spanning multiple lines is not detected.
+ * @param src io.Reader
+ * @return io.Reader
+ * Example: io.Copy(os.Stdout, DefaultRedactor.Reader(logFile))
+ */
+func (r *Redactor) Reader(src io.Reader) io.Reader {
+	return &redactReader{redactor: r, src: bufio.NewReader(src)}
+}
+
+// redactReader is the io.Reader returned by Redactor.Reader
+type redactReader struct {
+	redactor *Redactor
+	src      *bufio.Reader
+	pending  string
+	err      error
+}
+
+// Read reads redacted text into p
+func (rr *redactReader) Read(p []byte) (int, error) {
+	for rr.pending == "" && rr.err == nil {
+		var line string
+		line, rr.err = rr.src.ReadString('\n')
+		rr.pending = rr.redactor.Redact(line)
+	}
+	if rr.pending == "" {
+		return 0, rr.err
+	}
+	n := copy(p, rr.pending)
+	rr.pending = rr.pending[n:]
+	return n, nil
+}
+
+/*
+ * replaceMatches is a helper function to replace the matches of re which stand alone, i.e. are
+ * not surrounded by letters or digits. The span of the first capturing group which took part
+ * in the match is replaced, the whole match if there is none. accept may shorten the span
+ * by returning a smaller end, or reject it by returning -1.
+ * @param text string
+ * @param re *regexp.Regexp
+ * @param accept func(match string) int nil accepts every match
+ * @param replace func(match string) string
+ * @return string
+ */
+func replaceMatches(text string, re *regexp.Regexp, accept func(match string) int, replace func(string) string) string {
+	var result strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		for g := 2; g < len(loc); g += 2 {
+			if loc[g] != -1 {
+				start, end = loc[g], loc[g+1]
+				break
+			}
+		}
+		if accept != nil {
+			n := accept(text[start:end])
+			if n == -1 {
+				continue
+			}
+			end = start + n
+		}
+		if !standsAlone(text, start, end) {
+			continue
+		}
+		result.WriteString(text[last:start])
+		result.WriteString(replace(text[start:end]))
+		last = end
+	}
+	if last == 0 {
+		return text
+	}
+	result.WriteString(text[last:])
+	return result.String()
+}
+
+/*
+ * standsAlone is a helper function to check the span of text is not preceded or followed
+ * by a letter or a digit.
+ * @param text string
+ * @param start int
+ * @param end int
+ * @return bool
+ */
+func standsAlone(text string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	for _, r := range []rune{before, after} {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ * luhnPrefix is a helper function to find the longest prefix of a digit run which is a card
+ * number of 13 to 19 digits passing the Luhn check.
+ * @param match string
+ * @return int length of the prefix, -1 if there is none
+ */
+func luhnPrefix(match string) int {
+	for end := len(match); end > 0; end-- {
+		if end < len(match) && match[end] != ' ' && match[end] != '-' {
+			continue
+		}
+		compact := compactID(match[:end])
+		if len(compact) >= 13 && len(compact) <= 19 && validateLuhn(compact) == "" {
+			return end
+		}
+	}
+	return -1
+}
+
+/*
+ * bearerToken is a helper function to accept bearer tokens containing a digit or one of
+ * "._~+/-=", so prose like "the bearer authentication scheme" is kept.
+ * @param match string
+ * @return int length of the match, -1 if it is not a token
+ */
+func bearerToken(match string) int {
+	if strings.IndexAny(match, "0123456789._~+/-=") == -1 {
+		return -1
+	}
+	return len(match)
+}
+
+/*
+ * phoneNumber is a helper function to accept phone numbers of 7 to 15 digits, rejecting
+ * dates and IPv4 addresses.
+ * @param match string
+ * @return int length of the match, -1 if it is not a phone number
+ */
+func phoneNumber(match string) int {
+	digits := 0
+	for _, r := range match {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	if digits < 7 || digits > 15 || dateRegexp.MatchString(match) || checkIPv4(match) == "" {
+		return -1
+	}
+	return len(match)
+}
+
+/*
+ * maskEmail is a helper function to mask the local part of an email except for its first character.
+ * @param email string
+ * @return string
+ * Example: maskEmail("john@example.com") => "j***@example.com"
+ */
+func maskEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	return mask(email[:at], 1, 0, '*') + email[at:]
+}
+
+/*
+ * maskDigits is a helper function to mask all digits but the last keep ones, other
+ * characters such as separators are kept.
+ * @param input string
+ * @param keep int
+ * @return string
+ * Example: maskDigits("4111-1111-1111-1111", 4) => "****-****-****-1111"
+ */
+func maskDigits(input string, keep int) string {
+	result := []byte(input)
+	for idx := len(result) - 1; idx >= 0; idx-- {
+		if result[idx] >= '0' && result[idx] <= '9' {
+			if keep > 0 {
+				keep--
+			} else {
+				result[idx] = '*'
+			}
+		}
+	}
+	return string(result)
+}
+
+/*
+ * mask is a helper function to replace all runes of input but the first keepStart and the last
+ * keepEnd with maskRune. Input which is not longer than the kept runes is masked completely,
+ * so short secrets are never revealed.
+ * @param input string
+ * @param keepStart int
+ * @param keepEnd int
+ * @param maskRune rune
+ * @return string
+ * Example: mask("secret-token", 2, 2, '*') => "se********en"
+ */
+func mask(input string, keepStart, keepEnd int, maskRune rune) string {
+	runes := []rune(input)
+	if keepStart+keepEnd >= len(runes) {
+		keepStart, keepEnd = 0, 0
+	}
+	for idx := keepStart; idx < len(runes)-keepEnd; idx++ {
+		runes[idx] = maskRune
+	}
+	return string(runes)
+}
+
+/*
+* Mask replaces all characters but the first keepStart and the last keepEnd with maskRune.
+* A value which is not longer than the kept characters is masked completely.
+* it can be chained on function which return StringManipulation interface
+* @param keepStart int
+* @param keepEnd int
+* @param maskRune rune
+* @return StringManipulation
+* Example: "4111111111111111" => Mask(0, 4, '*') => "************1111"
+ */
+func (i *input) Mask(keepStart, keepEnd int, maskRune rune) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	if keepStart < 0 || keepEnd < 0 {
+		i.err = newOpError("Mask", input, []int{keepStart, keepEnd}, ErrNegativeLength)
+		setResult(i, "")
+		return i
+	}
+	setResult(i, mask(input, keepStart, keepEnd, maskRune))
+	return i
+}
+
+/*
+* Redact replaces PII detected by redactor, DefaultRedactor if it is nil
+* it can be chained on function which return StringManipulation interface
+* @param redactor *Redactor
+* @return StringManipulation
+* Example: "call +1 555 123 4567" => Redact(nil) => "call +* *** *** **67"
+ */
+func (i *input) Redact(redactor *Redactor) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	if redactor == nil {
+		redactor = DefaultRedactor
+	}
+	setResult(i, redactor.Redact(getInput(*i)))
+	return i
+}
@@ -0,0 +1,225 @@
+package stringy
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// DefaultRegexCacheSize is the number of compiled patterns kept by the regex methods
+const DefaultRegexCacheSize = 128
+
+/*
+ * PatternError describes a regular expression or glob pattern which can not be compiled. It is
+ * recorded by the regex methods and Glob wrapped in an *OpError and unwraps to ErrInvalidPattern.
+ * Example: "stringy: Match(a(b): error parsing regexp: missing closing ): `a(b`"
+ */
+type PatternError struct {
+	Pattern string // pattern which failed to compile
+	Err     error  // why the pattern is invalid, e.g. the error returned by regexp.Compile
+}
+
+// Error returns the compile error
+func (e *PatternError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns ErrInvalidPattern
+func (e *PatternError) Unwrap() error {
+	return ErrInvalidPattern
+}
+
+// regexEntry is a compiled pattern held by regexCache
+type regexEntry struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+/*
+ * regexCache keeps the most recently used compiled patterns so chains applying the same
+ * pattern to many values compile it once. It is safe for concurrent use.
+ */
+type regexCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // most recently used first
+	entries map[string]*list.Element
+}
+
+var patternCache = newRegexCache(DefaultRegexCacheSize)
+
+/*
+ * newRegexCache creates a regexCache holding at most size patterns.
+ * @param size int
+ * @return *regexCache
+ */
+func newRegexCache(size int) *regexCache {
+	return &regexCache{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+/*
+ * compile returns the compiled pattern from the cache, compiling and caching it on a miss.
+ * Patterns which fail to compile are not cached.
+ * @param pattern string
+ * @return *regexp.Regexp
+ * @return error *PatternError if the pattern is not a valid regular expression
+ */
+func (c *regexCache) compile(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(elem)
+		c.mu.Unlock()
+		return elem.Value.(*regexEntry).re, nil
+	}
+	c.mu.Unlock()
+
+	// compile outside the lock, a concurrent miss on the same pattern only costs a second compile
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, &PatternError{Pattern: pattern, Err: err}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*regexEntry).re, nil
+	}
+	if c.size <= 0 {
+		return re, nil
+	}
+	c.entries[pattern] = c.order.PushFront(&regexEntry{pattern: pattern, re: re})
+	c.evict()
+	return re, nil
+}
+
+// evict removes the least recently used patterns above the size of the cache, c.mu must be held
+func (c *regexCache) evict() {
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*regexEntry).pattern)
+	}
+}
+
+/*
+ * SetRegexCacheSize changes the number of compiled patterns kept by the regex methods,
+ * 0 disables the cache. Patterns above the new size are dropped, least recently used first.
+ * @param size int
+ */
+func SetRegexCacheSize(size int) {
+	if size < 0 {
+		size = 0
+	}
+	patternCache.mu.Lock()
+	defer patternCache.mu.Unlock()
+	patternCache.size = size
+	patternCache.evict()
+}
+
+/*
+ * regex is a helper function to compile pattern for the operation op, recording an
+ * *OpError on i if it is not a valid regular expression.
+ * @param i *input
+ * @param op string name of the method
+ * @param pattern string
+ * @return *regexp.Regexp nil if the chain already failed or the pattern is invalid
+ */
+func regex(i *input, op, pattern string) *regexp.Regexp {
+	if i.err != nil {
+		return nil
+	}
+	re, err := patternCache.compile(pattern)
+	if err != nil {
+		i.err = newOpError(op, getInput(*i), pattern, err)
+		return nil
+	}
+	return re
+}
+
+/*
+ * Match reports whether the value contains a match of the regular expression pattern.
+ * An invalid pattern records an error which can be read with Error.
+ * @param pattern string
+ * @return bool
+ * Example: "order-1234" => Match(`^order-\d+$`) => true
+ */
+func (i *input) Match(pattern string) bool {
+	re := regex(i, "Match", pattern)
+	if re == nil {
+		return false
+	}
+	return re.MatchString(getInput(*i))
+}
+
+/*
+ * FindAll returns all successive matches of the regular expression pattern.
+ * An invalid pattern records an error which can be read with Error.
+ * @param pattern string
+ * @return []string
+ * Example: "a1 b22 c333" => FindAll(`\d+`) => []string{"1", "22", "333"}
+ */
+func (i *input) FindAll(pattern string) []string {
+	re := regex(i, "FindAll", pattern)
+	if re == nil {
+		return []string{}
+	}
+	matches := re.FindAllString(getInput(*i), -1)
+	if matches == nil {
+		return []string{}
+	}
+	return matches
+}
+
+/*
+ * SplitRegex slices the value into the substrings separated by matches of the regular
+ * expression pattern. An invalid pattern records an error which can be read with Error.
+ * @param pattern string
+ * @return []string
+ * Example: "a, b;c" => SplitRegex(`[,;]\s*`) => []string{"a", "b", "c"}
+ */
+func (i *input) SplitRegex(pattern string) []string {
+	re := regex(i, "SplitRegex", pattern)
+	if re == nil {
+		return []string{}
+	}
+	return re.Split(getInput(*i), -1)
+}
+
+/*
+ * ReplaceRegex replaces every match of the regular expression pattern with repl, which may
+ * reference groups like regexp.ReplaceAllString, e.g. "$1" or "${name}".
+ * it can be chained on function which return StringManipulation interface
+ * An invalid pattern records an error which can be read with Error.
+ * @param pattern string
+ * @param repl string
+ * @return StringManipulation
+ * Example: "2024-01-31" => ReplaceRegex(`(\d+)-(\d+)-(\d+)`, "$3.$2.$1") => "31.01.2024"
+ */
+func (i *input) ReplaceRegex(pattern, repl string) StringManipulation {
+	re := regex(i, "ReplaceRegex", pattern)
+	if re == nil {
+		return i
+	}
+	setResult(i, re.ReplaceAllString(getInput(*i), repl))
+	return i
+}
+
+/*
+ * ReplaceRegexFunc replaces every match of the regular expression pattern with the value
+ * returned by fn for it. The replacement is used literally, a nil fn leaves the value unchanged.
+ * it can be chained on function which return StringManipulation interface
+ * An invalid pattern records an error which can be read with Error.
+ * @param pattern string
+ * @param fn func(string) string
+ * @return StringManipulation
+ * Example: "a1 b2" => ReplaceRegexFunc(`\d`, func(s string) string { return s + s }) => "a11 b22"
+ */
+func (i *input) ReplaceRegexFunc(pattern string, fn func(match string) string) StringManipulation {
+	re := regex(i, "ReplaceRegexFunc", pattern)
+	if re == nil || fn == nil {
+		return i
+	}
+	setResult(i, re.ReplaceAllStringFunc(getInput(*i), fn))
+	return i
+}
@@ -0,0 +1,135 @@
+package stringy
+
+import (
+	"errors"
+	"reflect"
+	"regexp/syntax"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Test Match, FindAll and SplitRegex
+func TestInput_Regex(t *testing.T) {
+	if !New("order-1234").Match(`^order-\d+$`) {
+		t.Errorf("Expected order-1234 to match")
+	}
+	if New("order-abc").Match(`^order-\d+$`) {
+		t.Errorf("Expected order-abc not to match")
+	}
+
+	testCases := []struct {
+		name    string
+		input   string
+		pattern string
+		find    []string
+		split   []string
+	}{
+		{"Digits", "a1 b22 c333", `\d+`, []string{"1", "22", "333"}, []string{"a", " b", " c", ""}},
+		{"Separators", "a, b;c", `[,;]\s*`, []string{", ", ";"}, []string{"a", "b", "c"}},
+		{"NoMatch", "hello", `\d`, []string{}, []string{"hello"}},
+		{"Unicode", "größe über", `\p{L}+`, []string{"größe", "über"}, []string{"", " ", ""}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if val := New(tc.input).FindAll(tc.pattern); !reflect.DeepEqual(val, tc.find) {
+				t.Errorf("Expected FindAll: %q but got: %q", tc.find, val)
+			}
+			if val := New(tc.input).SplitRegex(tc.pattern); !reflect.DeepEqual(val, tc.split) {
+				t.Errorf("Expected SplitRegex: %q but got: %q", tc.split, val)
+			}
+		})
+	}
+}
+
+// Test ReplaceRegex and ReplaceRegexFunc on a chain and on S
+func TestInput_ReplaceRegex(t *testing.T) {
+	if val := New("2024-01-31").ReplaceRegex(`(\d+)-(\d+)-(\d+)`, "$3.$2.$1").Get(); val != "31.01.2024" {
+		t.Errorf("Expected: 31.01.2024 but got: %s", val)
+	}
+	if val := New("user_name id").ReplaceRegex(`(?P<word>\w+)`, "<${word}>").Get(); val != "<user_name> <id>" {
+		t.Errorf("Expected: <user_name> <id> but got: %s", val)
+	}
+	if val := New("a1 b2").ReplaceRegexFunc(`\d`, func(s string) string { return s + s }).Get(); val != "a11 b22" {
+		t.Errorf("Expected: a11 b22 but got: %s", val)
+	}
+	if val := New("a1 b2").Trim().ReplaceRegexFunc(`\d`, nil).Get(); val != "a1 b2" {
+		t.Errorf("Expected a nil func to keep the value but got: %s", val)
+	}
+	if err := New("a1").ReplaceRegexFunc("(", nil).Error(); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern but got: %v", err)
+	}
+	if val := New("price: 5").ReplaceRegexFunc(`\d`, func(string) string { return "$1" }).Get(); val != "price: $1" {
+		t.Errorf("Expected the func result to be used literally but got: %s", val)
+	}
+	if val := Of(" hello   world ").Trim().ReplaceRegex(`\s+`, " ").ReplaceRegexFunc(`^\w`, strings.ToUpper).Get(); val != "Hello world" {
+		t.Errorf("Expected: Hello world but got: %s", val)
+	}
+}
+
+// Test invalid patterns are surfaced through Error
+func TestInput_RegexInvalidPattern(t *testing.T) {
+	sm := New("hello")
+	if sm.Match("a(b") {
+		t.Errorf("Expected an invalid pattern not to match")
+	}
+	err := sm.Error()
+	var patternErr *PatternError
+	if !errors.Is(err, ErrInvalidPattern) || !errors.As(err, &patternErr) || patternErr.Pattern != "a(b" {
+		t.Fatalf("Expected a PatternError for a(b but got: %v", err)
+	}
+	var syntaxErr *syntax.Error
+	if !errors.As(patternErr.Err, &syntaxErr) || syntaxErr.Code != syntax.ErrMissingParen {
+		t.Errorf("Expected the syntax error to be kept but got: %v", patternErr.Err)
+	}
+	if val := sm.ReplaceRegex("l", "L").Get(); val != "" {
+		t.Errorf("Expected the chain to keep the error but got: %s", val)
+	}
+
+	if val, err := Of("hello").ReplaceRegex("[", "x").GetE(); val != "" || !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern but got: %q, %v", val, err)
+	}
+}
+
+// Test the compiled pattern cache evicts the least recently used pattern
+func TestRegexCache(t *testing.T) {
+	cache := newRegexCache(2)
+	a, _ := cache.compile("a")
+	if _, err := cache.compile("b"); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := cache.compile("a"); again != a {
+		t.Errorf("Expected a cached pattern to be reused")
+	}
+	if _, err := cache.compile("c"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.entries["b"]; ok {
+		t.Errorf("Expected the least recently used pattern to be evicted")
+	}
+	if _, ok := cache.entries["a"]; !ok || cache.order.Len() != 2 {
+		t.Errorf("Expected a and c to be cached but got %d patterns", cache.order.Len())
+	}
+	if _, err := cache.compile("("); err == nil || cache.order.Len() != 2 {
+		t.Errorf("Expected invalid patterns not to be cached")
+	}
+
+	disabled := newRegexCache(0)
+	if re, err := disabled.compile("a"); err != nil || re == nil || disabled.order.Len() != 0 {
+		t.Errorf("Expected a disabled cache to compile without caching")
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 100; n++ {
+				if !New("x" + strings.Repeat("y", n%5)).Match("^xy{" + string(rune('0'+n%5)) + "}$") {
+					t.Errorf("Expected a match for %d", n)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,369 @@
+package stringy
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MatchOption changes how search strings are matched
+type MatchOption int
+
+// const below are the available match options, the default case sensitivity depends on the method
+const (
+	// CaseSensitive matches search strings exactly
+	CaseSensitive MatchOption = iota + 1
+	// CaseInsensitive matches search strings using Unicode simple case folding
+	CaseInsensitive
+	// WholeWord only matches search strings which are not surrounded by letters or digits
+	WholeWord
+	// PreserveCase applies the casing of the matched text (lower, UPPER, Title or camel) to the
+	// replacement. It implies CaseInsensitive unless CaseSensitive is passed as well.
+	PreserveCase
+)
+
+// matchConfig holds the resolved match options
+type matchConfig struct {
+	ignoreCase   bool
+	wholeWord    bool
+	preserveCase bool
+}
+
+/*
+ * newMatchConfig is a helper function to resolve match options on top of the default case sensitivity
+ * of a method, the last case option wins.
+ * @param ignoreCase bool default of the method
+ * @param opts []MatchOption
+ * @return matchConfig
+ */
+func newMatchConfig(ignoreCase bool, opts []MatchOption) matchConfig {
+	cfg := matchConfig{ignoreCase: ignoreCase}
+	explicit := false
+	for _, opt := range opts {
+		switch opt {
+		case CaseSensitive:
+			cfg.ignoreCase, explicit = false, true
+		case CaseInsensitive:
+			cfg.ignoreCase, explicit = true, true
+		case WholeWord:
+			cfg.wholeWord = true
+		case PreserveCase:
+			cfg.preserveCase = true
+		}
+	}
+	if cfg.preserveCase && !explicit {
+		cfg.ignoreCase = true
+	}
+	return cfg
+}
+
+/*
+ * replacement is a helper function to return the replacement for matched, with the casing of
+ * matched applied if the config asks for it.
+ * @param matched string
+ * @param replace string
+ * @return string
+ */
+func (cfg matchConfig) replacement(matched, replace string) string {
+	if cfg.preserveCase {
+		return applyCase(matched, replace)
+	}
+	return replace
+}
+
+/*
+ * applyCase is a helper function to apply the casing pattern of matched to replace: lower and
+ * UPPER are applied to the whole replacement, Title uppercases its first letter and lowercases
+ * the rest, camel and Pascal case only set the case of its first letter. Other patterns, or a
+ * matched text without letters, leave replace unchanged.
+ * @param matched string
+ * @param replace string
+ * @return string
+ * Example: applyCase("Color", "colour") => "Colour", applyCase("COLOR", "colour") => "COLOUR"
+ */
+func applyCase(matched, replace string) string {
+	letters, upper := 0, 0
+	firstUpper := false
+	for _, r := range matched {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if unicode.IsUpper(r) {
+			if letters == 0 {
+				firstUpper = true
+			}
+			upper++
+		}
+		letters++
+	}
+	if letters == 0 || replace == "" {
+		return replace
+	}
+
+	runes := []rune(replace)
+	switch {
+	case upper == 0:
+		return strings.ToLower(replace)
+	case upper == letters && letters > 1:
+		return strings.ToUpper(replace)
+	case firstUpper && upper == 1:
+		return string(unicode.ToUpper(runes[0])) + strings.ToLower(string(runes[1:]))
+	case firstUpper:
+		// Pascal case
+		runes[0] = unicode.ToUpper(runes[0])
+	default:
+		// camel case
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes)
+}
+
+/*
+ * caseFold is a helper function to map r to the smallest rune of its simple case folding orbit,
+ * so runes which are equal ignoring case map to the same rune, e.g. 'K', 'k' and the Kelvin sign.
+ * @param r rune
+ * @return rune
+ */
+func caseFold(r rune) rune {
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < folded {
+			folded = f
+		}
+	}
+	return folded
+}
+
+// acNode is a node of the Aho-Corasick automaton of a Replacer
+type acNode struct {
+	next    map[rune]int
+	fail    int // longest proper suffix which is a prefix of a pattern
+	dict    int // nearest node on the fail chain which ends a pattern, -1 if there is none
+	pattern int // index of the pattern ending at this node, -1 if there is none
+	depth   int // length in runes
+}
+
+/*
+ * Replacer replaces many search strings in a single pass using an Aho-Corasick automaton.
+ * At every position the leftmost match wins, and of matches starting at the same position
+ * the longest. Replacements are not searched again. A Replacer is immutable once created
+ * and safe for concurrent use.
+ * Example: NewReplacer(map[string]string{"a": "1", "ab": "2"}).Replace("abc") => "2c"
+ */
+type Replacer struct {
+	nodes        []acNode
+	replacements []string
+	cfg          matchConfig
+}
+
+/*
+ * NewReplacer compiles pairs of search strings and replacements. Matching is case sensitive
+ * unless CaseInsensitive is passed, WholeWord restricts matches to whole words and PreserveCase
+ * applies the casing of each match to its replacement. Empty search
+ * strings are ignored. When search strings are equal ignoring case, the replacement of the
+ * one sorting first is used.
+ * @param pairs map[string]string search strings and their replacements
+ * @param opts ...MatchOption
+ * @return *Replacer
+ * Example: NewReplacer(map[string]string{"cat": "dog"}, CaseInsensitive, WholeWord).Replace("Cat catalog") => "dog catalog"
+ */
+func NewReplacer(pairs map[string]string, opts ...MatchOption) *Replacer {
+	r := &Replacer{
+		nodes: []acNode{{next: map[rune]int{}, dict: -1, pattern: -1}},
+		cfg:   newMatchConfig(false, opts),
+	}
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		r.insert(key, pairs[key])
+	}
+	r.link()
+	return r
+}
+
+/*
+ * insert is a helper function to add a search string to the trie, keeping the first replacement
+ * of search strings which fold to the same key.
+ * @param search string
+ * @param replacement string
+ */
+func (r *Replacer) insert(search, replacement string) {
+	node := 0
+	for _, c := range search {
+		if r.cfg.ignoreCase {
+			c = caseFold(c)
+		}
+		child, ok := r.nodes[node].next[c]
+		if !ok {
+			child = len(r.nodes)
+			r.nodes = append(r.nodes, acNode{next: map[rune]int{}, dict: -1, pattern: -1, depth: r.nodes[node].depth + 1})
+			r.nodes[node].next[c] = child
+		}
+		node = child
+	}
+	if r.nodes[node].pattern == -1 {
+		r.nodes[node].pattern = len(r.replacements)
+		r.replacements = append(r.replacements, replacement)
+	}
+}
+
+/*
+ * link is a helper function to compute the fail and dictionary links breadth first.
+ */
+func (r *Replacer) link() {
+	queue := make([]int, 0, len(r.nodes))
+	for _, child := range r.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for c, child := range r.nodes[node].next {
+			fail := r.step(r.nodes[node].fail, c)
+			r.nodes[child].fail = fail
+			if r.nodes[fail].pattern != -1 {
+				r.nodes[child].dict = fail
+			} else {
+				r.nodes[child].dict = r.nodes[fail].dict
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+/*
+ * step is a helper function to follow the transition of node for c, falling back along fail links.
+ * @param node int
+ * @param c rune
+ * @return int
+ */
+func (r *Replacer) step(node int, c rune) int {
+	for {
+		if next, ok := r.nodes[node].next[c]; ok {
+			return next
+		}
+		if node == 0 {
+			return 0
+		}
+		node = r.nodes[node].fail
+	}
+}
+
+/*
+ * Replace returns input with all matches replaced.
+ * @param input string
+ * @return string
+ * Example: NewReplacer(map[string]string{"{name}": "Jane", "{day}": "Monday"}).Replace("Hi {name}, see you {day}") => "Hi Jane, see you Monday"
+ */
+func (r *Replacer) Replace(input string) string {
+	if r == nil || len(r.replacements) == 0 || input == "" {
+		return input
+	}
+
+	runes := []rune(input)
+	offsets := make([]int, 0, len(runes)+1)
+	for idx := range input {
+		offsets = append(offsets, idx)
+	}
+	offsets = append(offsets, len(input))
+
+	var result strings.Builder
+	last := 0
+	for pos := 0; pos < len(runes); {
+		start, length, pattern := r.leftmostLongest(input, runes, offsets, pos)
+		if pattern == -1 {
+			break
+		}
+		result.WriteString(input[last:offsets[start]])
+		result.WriteString(r.cfg.replacement(input[offsets[start]:offsets[start+length]], r.replacements[pattern]))
+		last = offsets[start+length]
+		pos = start + length
+	}
+	if last == 0 {
+		return input
+	}
+	result.WriteString(input[last:])
+	return result.String()
+}
+
+/*
+ * leftmostLongest is a helper function to find the leftmost longest match at or after rune
+ * position from. Scanning stops as soon as no partial match can start at or before the best
+ * match found so far.
+ * @param input string
+ * @param runes []rune runes of input
+ * @param offsets []int byte offset of every rune and the end of input
+ * @param from int
+ * @return int start of the match in runes
+ * @return int length of the match in runes
+ * @return int index of the pattern, -1 if there is no match
+ */
+func (r *Replacer) leftmostLongest(input string, runes []rune, offsets []int, from int) (int, int, int) {
+	start, length, pattern := -1, 0, -1
+	state := 0
+	for pos := from; pos < len(runes); pos++ {
+		c := runes[pos]
+		if r.cfg.ignoreCase {
+			c = caseFold(c)
+		}
+		state = r.step(state, c)
+		node := state
+		if r.nodes[node].pattern == -1 {
+			node = r.nodes[node].dict
+		}
+		for ; node != -1; node = r.nodes[node].dict {
+			depth := r.nodes[node].depth
+			s := pos + 1 - depth
+			if pattern != -1 && (s > start || (s == start && depth <= length)) {
+				continue
+			}
+			if r.cfg.wholeWord && !standsAlone(input, offsets[s], offsets[pos+1]) {
+				continue
+			}
+			start, length, pattern = s, depth, r.nodes[node].pattern
+		}
+		if pattern != -1 && pos+1-r.nodes[state].depth > start {
+			break
+		}
+	}
+	return start, length, pattern
+}
+
+/*
+* ReplaceMany replaces every key of pairs found in the input with its value in a single pass,
+* preferring the leftmost and then the longest match. Matching is case sensitive unless
+* CaseInsensitive is passed. Build a Replacer with NewReplacer to reuse the compiled pairs.
+* it can be chained on function which return StringManipulation interface
+* @param pairs map[string]string search strings and their replacements
+* @param opts ...MatchOption
+* @return StringManipulation
+* Example: "a cat and a dog" => ReplaceMany(map[string]string{"cat": "dog", "dog": "cat"}) => "a dog and a cat"
+ */
+func (i *input) ReplaceMany(pairs map[string]string, opts ...MatchOption) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	return i.ReplaceWith(NewReplacer(pairs, opts...))
+}
+
+/*
+* ReplaceWith replaces the input using a compiled Replacer
+* it can be chained on function which return StringManipulation interface
+* @param replacer *Replacer
+* @return StringManipulation
+* Example: "Hi {name}" => ReplaceWith(NewReplacer(map[string]string{"{name}": "Jane"})) => "Hi Jane"
+ */
+func (i *input) ReplaceWith(replacer *Replacer) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	setResult(i, replacer.Replace(getInput(*i)))
+	return i
+}
@@ -0,0 +1,108 @@
+package stringy
+
+import (
+	"sync"
+	"testing"
+)
+
+// Test Replacer with leftmost-longest semantics
+func TestReplacer_Replace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pairs    map[string]string
+		opts     []MatchOption
+		input    string
+		expected string
+	}{
+		{"Swap", map[string]string{"cat": "dog", "dog": "cat"}, nil, "a cat and a dog", "a dog and a cat"},
+		{"Longest", map[string]string{"a": "1", "ab": "2", "abc": "3"}, nil, "abcab", "32"},
+		{"Leftmost", map[string]string{"bcd": "X", "ab": "Y"}, nil, "abcd", "Ycd"},
+		{"LeftmostOverShorter", map[string]string{"abcd": "X", "bc": "Y"}, nil, "abcz", "aYz"},
+		{"Overlap", map[string]string{"he": "1", "she": "2", "his": "3", "hers": "4"}, nil, "ushers", "u2rs"},
+		{"NoMatch", map[string]string{"x": "y"}, nil, "hello", "hello"},
+		{"Empty", map[string]string{"": "x"}, nil, "hello", "hello"},
+		{"Unicode", map[string]string{"ß": "ss", "ä": "ae"}, nil, "Gräße", "Graesse"},
+		{"CaseSensitive", map[string]string{"Go": "Rust"}, nil, "go Go GO", "go Rust GO"},
+		{"CaseInsensitive", map[string]string{"go": "Rust"}, []MatchOption{CaseInsensitive}, "go Go GO", "Rust Rust Rust"},
+		{"KelvinSign", map[string]string{"k": "x"}, []MatchOption{CaseInsensitive}, "KKk", "xxx"},
+		{"WholeWord", map[string]string{"cat": "dog"}, []MatchOption{WholeWord}, "cat catalog bobcat cat.", "dog catalog bobcat dog."},
+		{"WholeWordShorter", map[string]string{"new": "N", "new york": "NY"}, []MatchOption{WholeWord}, "new yorker", "N yorker"},
+		{"Placeholders", map[string]string{"{name}": "Jane", "{day}": "Monday"}, nil, "Hi {name}, see you {day}", "Hi Jane, see you Monday"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if val := NewReplacer(tc.pairs, tc.opts...).Replace(tc.input); val != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, val)
+			}
+		})
+	}
+
+	var nilReplacer *Replacer
+	if val := nilReplacer.Replace("hello"); val != "hello" {
+		t.Errorf("Expected a nil replacer to keep the input but got: %s", val)
+	}
+}
+
+// Test ReplaceMany and ReplaceWith on a chain and a shared Replacer across goroutines
+func TestInput_ReplaceMany(t *testing.T) {
+	if val := New("Hello World").ReplaceMany(map[string]string{"hello": "Bye", "world": "Moon"}, CaseInsensitive).Get(); val != "Bye Moon" {
+		t.Errorf("Expected: Bye Moon but got: %s", val)
+	}
+	if val := Of(" a-b ").Trim().ReplaceMany(map[string]string{"-": "+"}).Get(); val != "a+b" {
+		t.Errorf("Expected: a+b but got: %s", val)
+	}
+
+	replacer := NewReplacer(map[string]string{"{n}": "42"})
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 100; n++ {
+				if val := New("n={n}").ReplaceWith(replacer).Get(); val != "n=42" {
+					t.Errorf("Expected: n=42 but got: %s", val)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Test case-preserving replacement
+func TestInput_ReplacePreserveCase(t *testing.T) {
+	testCases := []struct {
+		name     string
+		run      func(sm StringManipulation) string
+		input    string
+		expected string
+	}{
+		{"FirstTitle", func(sm StringManipulation) string { return sm.ReplaceFirst("color", "colour", PreserveCase) }, "Color me", "Colour me"},
+		{"FirstVerbatim", func(sm StringManipulation) string { return sm.ReplaceFirst("color", "colour") }, "Color me", "colour me"},
+		{"FirstUpper", func(sm StringManipulation) string { return sm.ReplaceFirst("color", "colour", PreserveCase) }, "COLOR me", "COLOUR me"},
+		{"LastLower", func(sm StringManipulation) string { return sm.ReplaceLast("COLOR", "Colour", PreserveCase) }, "Color and color", "Color and colour"},
+		{"LastCamel", func(sm StringManipulation) string { return sm.ReplaceLast("username", "AccountId", PreserveCase) }, "userName", "accountId"},
+		{"FirstPascal", func(sm StringManipulation) string { return sm.ReplaceFirst("username", "accountId", PreserveCase) }, "UserName", "AccountId"},
+		{"FirstCaseSensitive", func(sm StringManipulation) string {
+			return sm.ReplaceFirst("color", "colour", CaseSensitive, PreserveCase)
+		}, "Color color", "Color colour"},
+		{"FirstWholeWord", func(sm StringManipulation) string { return sm.ReplaceFirst("cat", "dog", WholeWord) }, "catalog cat", "catalog dog"},
+		{"LastWholeWord", func(sm StringManipulation) string { return sm.ReplaceLast("cat", "dog", WholeWord) }, "cat catalog", "dog catalog"},
+		{"AllMixed", func(sm StringManipulation) string { return sm.ReplaceAll("color", "colour", PreserveCase).Get() }, "Color, color or COLOR", "Colour, colour or COLOUR"},
+		{"AllSingleLetter", func(sm StringManipulation) string { return sm.ReplaceAll("a", "xy", PreserveCase).Get() }, "A a", "Xy xy"},
+		{"AllNoLetters", func(sm StringManipulation) string { return sm.ReplaceAll("1", "one", PreserveCase).Get() }, "1 2 1", "one 2 one"},
+		{"AllCaseSensitive", func(sm StringManipulation) string { return sm.ReplaceAll("color", "colour").Get() }, "Color color", "Color colour"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if val := tc.run(New(tc.input)); val != tc.expected {
+				t.Errorf("Expected: %s but got: %s", tc.expected, val)
+			}
+		})
+	}
+
+	replacer := NewReplacer(map[string]string{"cat": "dog", "mouse": "hamster"}, PreserveCase)
+	if val := replacer.Replace("Cat chases MOUSE"); val != "Dog chases HAMSTER" {
+		t.Errorf("Expected: Dog chases HAMSTER but got: %s", val)
+	}
+}
@@ -0,0 +1,477 @@
+package stringy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// EditCosts holds the costs of the edit operations used by Levenshtein distances
+type EditCosts struct {
+	Insert     int
+	Delete     int
+	Substitute int
+}
+
+// DefaultEditCosts gives every edit operation a cost of 1
+var DefaultEditCosts = EditCosts{Insert: 1, Delete: 1, Substitute: 1}
+
+/*
+ * Comparer computes distances and similarities between strings. All metrics work on
+ * runes, so multi-byte characters count as one character. The zero value compares
+ * strings exactly with unit edit costs. A Comparer is safe for concurrent use.
+ */
+type Comparer struct {
+	IgnoreCase       bool      // compare case-insensitively
+	IgnoreDiacritics bool      // compare "é" equal to "e", "ß" equal to "ss"
+	Costs            EditCosts // costs for Levenshtein, zero fields default to 1
+}
+
+/*
+ * withDefaults is a helper function to replace the zero costs with their default, so a
+ * partial EditCosts like EditCosts{Substitute: 2} keeps unit insertions and deletions.
+ * @return EditCosts
+ */
+func (e EditCosts) withDefaults() EditCosts {
+	if e.Insert == 0 {
+		e.Insert = DefaultEditCosts.Insert
+	}
+	if e.Delete == 0 {
+		e.Delete = DefaultEditCosts.Delete
+	}
+	if e.Substitute == 0 {
+		e.Substitute = DefaultEditCosts.Substitute
+	}
+	return e
+}
+
+/*
+ * normalize is a helper function to apply the comparer options to both strings.
+ * @param a string
+ * @param b string
+ * @return []rune
+ * @return []rune
+ */
+func (c Comparer) normalize(a, b string) ([]rune, []rune) {
+	if c.IgnoreDiacritics {
+		a, b = removeDiacritics(a), removeDiacritics(b)
+	}
+	if c.IgnoreCase {
+		a, b = strings.Map(foldLower, a), strings.Map(foldLower, b)
+	}
+	return []rune(a), []rune(b)
+}
+
+/*
+ * foldLower is a helper function to map r like caseFold, so the comparer agrees with the case
+ * insensitive matching of the replace methods, but to a lowercase rune, e.g. for LCS results.
+ * @param r rune
+ * @return rune
+ */
+func foldLower(r rune) rune {
+	return unicode.ToLower(caseFold(r))
+}
+
+/*
+ * Levenshtein returns the minimum cost of insertions, deletions and substitutions
+ * needed to turn a into b, using the costs of the comparer.
+ * @param a string
+ * @param b string
+ * @return int
+ * Example: Comparer{}.Levenshtein("kitten", "sitting") => 3
+ */
+func (c Comparer) Levenshtein(a, b string) int {
+	ra, rb := c.normalize(a, b)
+	costs := c.Costs.withDefaults()
+
+	// a single row is enough, prev holds the value diagonally above the current cell
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j * costs.Insert
+	}
+	for i := 1; i <= len(ra); i++ {
+		prev := row[0]
+		row[0] = i * costs.Delete
+		for j := 1; j <= len(rb); j++ {
+			substitute := prev
+			if ra[i-1] != rb[j-1] {
+				substitute += costs.Substitute
+			}
+			prev = row[j]
+			row[j] = minInt(substitute, minInt(row[j]+costs.Delete, row[j-1]+costs.Insert))
+		}
+	}
+	return row[len(rb)]
+}
+
+/*
+ * DamerauLevenshtein returns the edit distance between a and b where, on top of insertions,
+ * deletions and substitutions, a transposition of two adjacent characters counts as one edit.
+ * This is the unrestricted variant, so substrings may be edited more than once.
+ * @param a string
+ * @param b string
+ * @return int
+ * Example: Comparer{}.DamerauLevenshtein("ca", "abc") => 2
+ */
+func (c Comparer) DamerauLevenshtein(a, b string) int {
+	ra, rb := c.normalize(a, b)
+	n, m := len(ra), len(rb)
+	maxDist := n + m
+	width := m + 2
+
+	// d is the (n+2)x(m+2) matrix stored row by row
+	d := make([]int, (n+2)*width)
+	d[0] = maxDist
+	for i := 0; i <= n; i++ {
+		d[(i+1)*width] = maxDist
+		d[(i+1)*width+1] = i
+	}
+	for j := 0; j <= m; j++ {
+		d[j+1] = maxDist
+		d[width+j+1] = j
+	}
+
+	lastRow := make(map[rune]int)
+	for i := 1; i <= n; i++ {
+		lastCol := 0
+		for j := 1; j <= m; j++ {
+			k := lastRow[rb[j-1]]
+			l := lastCol
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			d[(i+1)*width+j+1] = minInt(
+				minInt(d[i*width+j]+cost, d[(i+1)*width+j]+1),
+				minInt(d[i*width+j+1]+1, d[k*width+l]+(i-k-1)+1+(j-l-1)),
+			)
+		}
+		lastRow[ra[i-1]] = i
+	}
+	return d[(n+1)*width+m+1]
+}
+
+/*
+ * Hamming returns the number of positions at which a and b differ.
+ * @param a string
+ * @param b string
+ * @return int
+ * @return error ErrLengthMismatch wrapped in *OpError if a and b differ in length
+ * Example: Comparer{}.Hamming("karolin", "kathrin") => 3, nil
+ */
+func (c Comparer) Hamming(a, b string) (int, error) {
+	ra, rb := c.normalize(a, b)
+	if len(ra) != len(rb) {
+		return 0, newOpError("Hamming", a, b, ErrLengthMismatch)
+	}
+	distance := 0
+	for idx := range ra {
+		if ra[idx] != rb[idx] {
+			distance++
+		}
+	}
+	return distance, nil
+}
+
+/*
+ * Jaro returns the Jaro similarity of a and b between 0 (no similarity) and 1 (equal).
+ * @param a string
+ * @param b string
+ * @return float64
+ */
+func (c Comparer) Jaro(a, b string) float64 {
+	ra, rb := c.normalize(a, b)
+	return jaro(ra, rb)
+}
+
+/*
+ * JaroWinkler returns the Jaro-Winkler similarity of a and b between 0 and 1. It boosts
+ * the Jaro similarity of strings sharing a common prefix of up to 4 characters.
+ * @param a string
+ * @param b string
+ * @return float64
+ * Example: Comparer{}.JaroWinkler("MARTHA", "MARHTA") => 0.9611
+ */
+func (c Comparer) JaroWinkler(a, b string) float64 {
+	ra, rb := c.normalize(a, b)
+	similarity := jaro(ra, rb)
+	if similarity <= 0.7 {
+		return similarity
+	}
+	prefix := 0
+	for prefix < 4 && prefix < len(ra) && prefix < len(rb) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+	return similarity + float64(prefix)*0.1*(1-similarity)
+}
+
+/*
+ * LCS returns the longest common subsequence of a and b, the longest sequence of
+ * characters appearing in both strings in the same order but not necessarily adjacent.
+ * When options are set the subsequence is taken from the normalized form of a.
+ * @param a string
+ * @param b string
+ * @return string
+ * Example: Comparer{}.LCS("ABCBDAB", "BDCABA") => "BDAB"
+ */
+func (c Comparer) LCS(a, b string) string {
+	ra, rb := c.normalize(a, b)
+	n, m := len(ra), len(rb)
+	width := m + 1
+	lengths := make([]int, (n+1)*width)
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if ra[i] == rb[j] {
+				lengths[i*width+j] = lengths[(i+1)*width+j+1] + 1
+			} else {
+				lengths[i*width+j] = maxInt(lengths[(i+1)*width+j], lengths[i*width+j+1])
+			}
+		}
+	}
+
+	result := make([]rune, 0, lengths[0])
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case ra[i] == rb[j]:
+			result = append(result, ra[i])
+			i++
+			j++
+		case lengths[(i+1)*width+j] >= lengths[i*width+j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return string(result)
+}
+
+/*
+ * Similarity returns the normalized Levenshtein similarity of a and b between 0 and 1,
+ * computed as 1 - distance / length of the longer string. Edit costs are ignored.
+ * @param a string
+ * @param b string
+ * @return float64
+ * Example: Comparer{}.Similarity("kitten", "sitting") => 0.5714
+ */
+func (c Comparer) Similarity(a, b string) float64 {
+	ra, rb := c.normalize(a, b)
+	longest := maxInt(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	unit := Comparer{}
+	return 1 - float64(unit.Levenshtein(string(ra), string(rb)))/float64(longest)
+}
+
+/*
+ * jaro is a helper function to compute the Jaro similarity of two rune slices.
+ * @param a []rune
+ * @param b []rune
+ * @return float64
+ */
+func jaro(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	window := maxInt(len(a), len(b))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		start, end := maxInt(0, i-window), minInt(len(b), i+window+1)
+		for j := start; j < end; j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	transpositions, j := 0, 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+}
+
+/*
+ * minInt is a helper function to return the smaller of two ints.
+ * @param a int
+ * @param b int
+ * @return int
+ */
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+/*
+ * maxInt is a helper function to return the larger of two ints.
+ * @param a int
+ * @param b int
+ * @return int
+ */
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// defaultComparer is used by the package level metrics
+var defaultComparer = Comparer{}
+
+/*
+* WithComparer makes the similarity chain methods, e.g. Levenshtein and Similarity, compare
+* with c instead of the exact default Comparer.
+* @param c Comparer
+* @return Option
+* Example: New("José", WithComparer(Comparer{IgnoreCase: true, IgnoreDiacritics: true})).Levenshtein("JOSE") => 0
+ */
+func WithComparer(c Comparer) Option {
+	return func(i *input) {
+		i.comparer = c
+	}
+}
+
+// Levenshtein returns the Levenshtein distance of a and b with unit costs, see Comparer.Levenshtein
+func Levenshtein(a, b string) int {
+	return defaultComparer.Levenshtein(a, b)
+}
+
+// DamerauLevenshtein returns the Damerau-Levenshtein distance of a and b, see Comparer.DamerauLevenshtein
+func DamerauLevenshtein(a, b string) int {
+	return defaultComparer.DamerauLevenshtein(a, b)
+}
+
+// Hamming returns the Hamming distance of a and b, see Comparer.Hamming
+func Hamming(a, b string) (int, error) {
+	return defaultComparer.Hamming(a, b)
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of a and b, see Comparer.JaroWinkler
+func JaroWinkler(a, b string) float64 {
+	return defaultComparer.JaroWinkler(a, b)
+}
+
+// LCS returns the longest common subsequence of a and b, see Comparer.LCS
+func LCS(a, b string) string {
+	return defaultComparer.LCS(a, b)
+}
+
+/*
+* Levenshtein returns the Levenshtein distance between the input and other
+* it can be chained on function which return StringManipulation interface
+* @param other string
+* @return int
+* Example: "kitten" => Levenshtein("sitting") => 3
+ */
+func (i *input) Levenshtein(other string) int {
+	if i.err != nil {
+		return 0
+	}
+
+	return i.comparer.Levenshtein(getInput(*i), other)
+}
+
+/*
+* DamerauLevenshtein returns the Damerau-Levenshtein distance between the input and other
+* it can be chained on function which return StringManipulation interface
+* @param other string
+* @return int
+* Example: "ab" => DamerauLevenshtein("ba") => 1
+ */
+func (i *input) DamerauLevenshtein(other string) int {
+	if i.err != nil {
+		return 0
+	}
+
+	return i.comparer.DamerauLevenshtein(getInput(*i), other)
+}
+
+/*
+* Hamming returns the Hamming distance between the input and other
+* it can be chained on function which return StringManipulation interface
+* @param other string
+* @return int
+* Note: If the input and other differ in length, it returns 0 and sets an error.
+* Example: "karolin" => Hamming("kathrin") => 3
+ */
+func (i *input) Hamming(other string) int {
+	if i.err != nil {
+		return 0
+	}
+
+	distance, err := i.comparer.Hamming(getInput(*i), other)
+	if err != nil {
+		i.err = err
+		return 0
+	}
+	return distance
+}
+
+/*
+* JaroWinkler returns the Jaro-Winkler similarity between the input and other
+* it can be chained on function which return StringManipulation interface
+* @param other string
+* @return float64 between 0 and 1
+* Example: "MARTHA" => JaroWinkler("MARHTA") => 0.9611
+ */
+func (i *input) JaroWinkler(other string) float64 {
+	if i.err != nil {
+		return 0
+	}
+
+	return i.comparer.JaroWinkler(getInput(*i), other)
+}
+
+/*
+* LCS returns the longest common subsequence of the input and other
+* it can be chained on function which return StringManipulation interface
+* @param other string
+* @return string
+* Example: "ABCBDAB" => LCS("BDCABA") => "BDAB"
+ */
+func (i *input) LCS(other string) string {
+	if i.err != nil {
+		return ""
+	}
+
+	return i.comparer.LCS(getInput(*i), other)
+}
+
+/*
+* Similarity returns the normalized Levenshtein similarity between the input and other
+* it can be chained on function which return StringManipulation interface
+* @param other string
+* @return float64 between 0 and 1
+* Example: "kitten" => Similarity("sitting") => 0.5714
+ */
+func (i *input) Similarity(other string) float64 {
+	if i.err != nil {
+		return 0
+	}
+
+	return i.comparer.Similarity(getInput(*i), other)
+}
@@ -0,0 +1,190 @@
+package stringy
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// almostEqual compares floats with the precision used in the test vectors
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-4
+}
+
+// Test Levenshtein distance
+func TestLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"gumbo", "gambol", 2},
+		{"世界", "世间", 1},
+		{"😀a", "a😀", 2},
+	}
+	for _, tc := range testCases {
+		if val := Levenshtein(tc.a, tc.b); val != tc.expected {
+			t.Errorf("Levenshtein(%q, %q) - Expected: %d but got: %d", tc.a, tc.b, tc.expected, val)
+		}
+	}
+
+	costs := Comparer{Costs: EditCosts{Insert: 1, Delete: 1, Substitute: 3}}
+	if val := costs.Levenshtein("kitten", "sitting"); val != 5 {
+		t.Errorf("Levenshtein with costs - Expected: 5 but got: %d", val)
+	}
+	if val := (Comparer{Costs: EditCosts{Substitute: 2}}).Levenshtein("kitten", "sitting"); val != 5 {
+		t.Errorf("Levenshtein with a partial cost - Expected: 5 but got: %d", val)
+	}
+	if val := (Comparer{Costs: EditCosts{Insert: 5}}).Levenshtein("kitten", "sitting"); val != 7 {
+		t.Errorf("Levenshtein with a partial cost - Expected: 7 but got: %d", val)
+	}
+}
+
+// Test Damerau-Levenshtein distance
+func TestDamerauLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"ab", "ba", 1},
+		{"ca", "abc", 2},
+		{"kitten", "sitting", 3},
+		{"a cat", "an act", 2},
+		{"Müller", "Mülelr", 1},
+	}
+	for _, tc := range testCases {
+		if val := DamerauLevenshtein(tc.a, tc.b); val != tc.expected {
+			t.Errorf("DamerauLevenshtein(%q, %q) - Expected: %d but got: %d", tc.a, tc.b, tc.expected, val)
+		}
+	}
+}
+
+// Test Hamming distance
+func TestHamming(t *testing.T) {
+	if val, err := Hamming("karolin", "kathrin"); val != 3 || err != nil {
+		t.Errorf("Expected: 3 but got: %d, %v", val, err)
+	}
+	if val, err := Hamming("héllo", "hallo"); val != 1 || err != nil {
+		t.Errorf("Expected: 1 but got: %d, %v", val, err)
+	}
+	if _, err := Hamming("abc", "ab"); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Expected ErrLengthMismatch but got: %v", err)
+	}
+}
+
+// Test Jaro and Jaro-Winkler similarity
+func TestJaroWinkler(t *testing.T) {
+	testCases := []struct {
+		a, b          string
+		jaro, winkler float64
+	}{
+		{"MARTHA", "MARHTA", 0.9444, 0.9611},
+		{"DWAYNE", "DUANE", 0.8222, 0.84},
+		{"DIXON", "DICKSONX", 0.7667, 0.8133},
+		{"abc", "xyz", 0, 0},
+		{"", "", 1, 1},
+		{"same", "same", 1, 1},
+	}
+	for _, tc := range testCases {
+		if val := (Comparer{}).Jaro(tc.a, tc.b); !almostEqual(val, tc.jaro) {
+			t.Errorf("Jaro(%q, %q) - Expected: %v but got: %v", tc.a, tc.b, tc.jaro, val)
+		}
+		if val := JaroWinkler(tc.a, tc.b); !almostEqual(val, tc.winkler) {
+			t.Errorf("JaroWinkler(%q, %q) - Expected: %v but got: %v", tc.a, tc.b, tc.winkler, val)
+		}
+	}
+}
+
+// Test longest common subsequence
+func TestLCS(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected string
+	}{
+		{"ABCBDAB", "BDCABA", "BDAB"},
+		{"", "abc", ""},
+		{"abc", "abc", "abc"},
+		{"日本語テキスト", "日本のテキスト", "日本テキスト"},
+	}
+	for _, tc := range testCases {
+		if val := LCS(tc.a, tc.b); val != tc.expected {
+			t.Errorf("LCS(%q, %q) - Expected: %s but got: %s", tc.a, tc.b, tc.expected, val)
+		}
+	}
+}
+
+// Test case and diacritic insensitive comparison
+func TestComparer_Options(t *testing.T) {
+	insensitive := Comparer{IgnoreCase: true, IgnoreDiacritics: true}
+	if val := insensitive.Levenshtein("José Müller", "jose muller"); val != 0 {
+		t.Errorf("Levenshtein - Expected: 0 but got: %d", val)
+	}
+	if val := insensitive.Similarity("STRASSE", "straße"); val != 1 {
+		t.Errorf("Similarity - Expected: 1 but got: %v", val)
+	}
+	if val := insensitive.JaroWinkler("Zoë", "zoe"); val != 1 {
+		t.Errorf("JaroWinkler - Expected: 1 but got: %v", val)
+	}
+	if val := (Comparer{IgnoreCase: true}).Levenshtein("José", "JOSE"); val != 1 {
+		t.Errorf("Levenshtein case only - Expected: 1 but got: %d", val)
+	}
+	if val := Levenshtein("José", "jose"); val != 2 {
+		t.Errorf("Levenshtein exact - Expected: 2 but got: %d", val)
+	}
+	if val := (Comparer{IgnoreCase: true}).Levenshtein("\u017f \u212a", "S k"); val != 0 {
+		t.Errorf("Levenshtein case folding - Expected: 0 but got: %d", val)
+	}
+	if val := (Comparer{IgnoreCase: true}).LCS("HELLO", "yellow"); val != "ello" {
+		t.Errorf("LCS case only - Expected: ello but got: %s", val)
+	}
+}
+
+// Test similarity chain methods
+func TestInput_Similarity(t *testing.T) {
+	sm := New("kitten")
+	if val := sm.Levenshtein("sitting"); val != 3 {
+		t.Errorf("Levenshtein - Expected: 3 but got: %d", val)
+	}
+	if val := sm.DamerauLevenshtein("iktten"); val != 1 {
+		t.Errorf("DamerauLevenshtein - Expected: 1 but got: %d", val)
+	}
+	if val := sm.Similarity("sitting"); !almostEqual(val, 0.5714) {
+		t.Errorf("Similarity - Expected: 0.5714 but got: %v", val)
+	}
+	if val := sm.LCS("sitting"); val != "ittn" {
+		t.Errorf("LCS - Expected: ittn but got: %s", val)
+	}
+	if val := New("MARTHA").JaroWinkler("MARHTA"); !almostEqual(val, 0.9611) {
+		t.Errorf("JaroWinkler - Expected: 0.9611 but got: %v", val)
+	}
+	if val := New("karolin").Hamming("kathrin"); val != 3 {
+		t.Errorf("Hamming - Expected: 3 but got: %d", val)
+	}
+	sm = New("abc")
+	sm.Hamming("abcd")
+	if !errors.Is(sm.Error(), ErrLengthMismatch) {
+		t.Errorf("Expected ErrLengthMismatch but got: %v", sm.Error())
+	}
+}
+
+// Test WithComparer configures the similarity chain methods
+func TestInput_WithComparer(t *testing.T) {
+	sm := New("José Müller", WithComparer(Comparer{IgnoreCase: true, IgnoreDiacritics: true}))
+	if val := sm.Levenshtein("JOSE MULLER"); val != 0 {
+		t.Errorf("Levenshtein - Expected: 0 but got: %d", val)
+	}
+	if val := sm.Similarity("jose muller"); val != 1 {
+		t.Errorf("Similarity - Expected: 1 but got: %v", val)
+	}
+	if val := New("kitten", WithComparer(Comparer{Costs: EditCosts{Substitute: 3}})).Levenshtein("sitting"); val != 5 {
+		t.Errorf("Levenshtein with costs - Expected: 5 but got: %d", val)
+	}
+	if val := New("José Müller").Levenshtein("JOSE MULLER"); val == 0 {
+		t.Errorf("Expected New to compare exactly by default")
+	}
+}
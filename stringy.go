@@ -1,7 +1,6 @@
 package stringy
 
 import (
-	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -12,73 +11,133 @@ import (
 
 // input is struct that holds input from user and result
 type input struct {
-	Input  string
-	Result string
-	err    error
+	Input     string
+	Result    string
+	err       error
+	hasResult bool
+	released  bool
+	rand      *rand.Rand
+	comparer  Comparer
 }
 
-// StringManipulation is an interface that holds all abstract methods to manipulate strings
+// StringManipulation is an interface that holds all abstract methods to manipulate strings.
+// Once a method records an error every following method respects it: chainable methods
+// return the value unchanged and all other methods return their zero value. The error
+// of the first failing step is kept and can be read with Error, GetE or MustGet.
 type StringManipulation interface {
 	Acronym() StringManipulation
-	Between(start, end string) StringManipulation
+	Between(start, end string, opts ...MatchOption) StringManipulation
+	BetweenAll(start, end string, opts ...BetweenOption) []string
+	BetweenSpans(start, end string, opts ...BetweenOption) []Span
 	Boolean() bool
+	ByteSize() int64
+	ByteSizeOrDefault(def int64) int64
+	BooleanWith(parser *BoolParser) bool
+	BooleanState(parser *BoolParser) BoolState
 	CamelCase(rule ...string) StringManipulation
+	Clone() StringManipulation
 	ContainsAll(check ...string) bool
+	DamerauLevenshtein(other string) int
 	Delimited(delimiter string, rule ...string) StringManipulation
+	DoubleMetaphone() (string, string)
+	Duration() time.Duration
+	DurationOrDefault(def time.Duration) time.Duration
 	Error() error // New method to retrieve errors
+	ExpandEnv(opts ...InterpolateOption) StringManipulation
 	First(length int) string
+	Format(id Identifier) StringManipulation
+	FromRoman() StringManipulation
+	FindAll(pattern string) []string
+	Float() float64
+	FloatOrDefault(def float64) float64
 	Get() string
+	GetE() (string, error)
+	Glob(pattern string, opts ...MatchOption) bool
+	Hamming(other string) int
+	Int() int64
+	Interpolate(vars map[string]string, opts ...InterpolateOption) StringManipulation
+	InterpolateWith(resolver Resolver, opts ...InterpolateOption) StringManipulation
+	IntOrDefault(def int64) int64
+	JaroWinkler(other string) float64
 	KebabCase(rule ...string) StringManipulation
 	Last(length int) string
+	LCS(other string) string
 	LcFirst() string
+	Levenshtein(other string) int
 	Lines() []string
+	Mask(keepStart, keepEnd int, maskRune rune) StringManipulation
+	Match(pattern string) bool
+	Metaphone() string
+	MustGet() string
+	Normalize(id Identifier) StringManipulation
+	NumberToWords() StringManipulation
+	NYSIIS() string
+	Ordinalize() StringManipulation
+	OrdinalToWords() StringManipulation
 	Pad(length int, with, padType string) string
 	PascalCase(rule ...string) StringManipulation
+	Pluralize() StringManipulation
+	Percent() float64
+	PercentOrDefault(def float64) float64
 	Prefix(with string) string
 	RemoveSpecialCharacter() string
-	ReplaceFirst(search, replace string) string
-	ReplaceLast(search, replace string) string
+	Redact(redactor *Redactor) StringManipulation
+	ReplaceFirst(search, replace string, opts ...MatchOption) string
+	ReplaceLast(search, replace string, opts ...MatchOption) string
+	ReplaceMany(pairs map[string]string, opts ...MatchOption) StringManipulation
+	ReplaceRegex(pattern, repl string) StringManipulation
+	ReplaceRegexFunc(pattern string, fn func(match string) string) StringManipulation
+	ReplaceWith(replacer *Replacer) StringManipulation
+	Release()
 	Reverse() string
 	SentenceCase(rule ...string) StringManipulation
 	Shuffle() string
+	ShuffleWith(r *rand.Rand) string
+	Similarity(other string) float64
+	Singularize() StringManipulation
 	SnakeCase(rule ...string) StringManipulation
+	Soundex() string
+	SplitRegex(pattern string) []string
 	Suffix(with string) string
+	Suggest(candidates []string, maxDistance int) []string
+	SuggestFrom(suggester *Suggester, maxDistance int) []string
 	Surround(with string) string
 	Tease(length int, indicator string) string
 	Title() string
 	ToLower() string
+	ToRoman() StringManipulation
 	Trim(cutset ...string) StringManipulation
 	ToUpper() string
 	UcFirst() string
 	TruncateWords(count int, suffix string) StringManipulation
+	Validate(rules ...Rule) StringManipulation
 	WordCount() int
+	IsAlpha() bool
+	IsAlphanumeric() bool
+	IsASCII() bool
+	IsBase64() bool
+	IsEmail() bool
 	IsEmpty() bool
+	IsHex() bool
+	IsHostname() bool
+	IsIPv4() bool
+	IsIPv6() bool
+	IsJSON() bool
+	IsNumeric() bool
+	IsURL() bool
+	IsUUID(version int) bool
 	Substring(start, end int) StringManipulation
 	SlugifyWithCount(count int) StringManipulation
 	Contains(substring string) bool
-	ReplaceAll(search, replace string) StringManipulation
+	ReplaceAll(search, replace string, opts ...MatchOption) StringManipulation
 }
 
-var trueMap, falseMap map[string]struct{}
-
 var inputPool = sync.Pool{
 	New: func() interface{} {
 		return &input{}
 	},
 }
 
-func init() {
-	trueMap = make(map[string]struct{}, len(True))
-	for _, s := range True {
-		trueMap[s] = struct{}{}
-	}
-
-	falseMap = make(map[string]struct{}, len(False))
-	for _, s := range False {
-		falseMap[s] = struct{}{}
-	}
-}
-
 /*
  * Acronym takes input string and returns acronym of the string
  * it can be chained on function which return StringManipulation interface
@@ -86,6 +145,10 @@ func init() {
  */
 
 func (i *input) Acronym() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
 	input := getInput(*i)
 	words := strings.Fields(input)
 	var acronym strings.Builder
@@ -97,19 +160,23 @@ func (i *input) Acronym() StringManipulation {
 		}
 	}
 
-	i.Result = acronym.String()
+	setResult(i, acronym.String())
 	return i
 }
 
 /*
  * Between takes two param start and end and returns string between start and end
  * it can be chained on function which return StringManipulation interface
+ * Matching is case-insensitive by default using Unicode case folding, pass CaseSensitive
+ * to match start and end exactly or WholeWord to match them as whole words only.
  * @param start string
  * @param end string
+ * @param opts ...MatchOption
  * @return StringManipulation
  * Note: If start and end are empty, it returns the input string.
+ * Example: "KELVIN 5 K" => Between("kelvin", "k") => " 5 "
  */
-func (i *input) Between(start, end string) StringManipulation {
+func (i *input) Between(start, end string, opts ...MatchOption) StringManipulation {
 	// Check for existing error
 	if i.err != nil {
 		return i
@@ -119,74 +186,33 @@ func (i *input) Between(start, end string) StringManipulation {
 
 	// Special case: if both start and end are empty, return the input
 	if start == "" && end == "" {
-		i.Result = input
-		return i
-	}
-
-	// Special case: if input is empty, return empty
-	if input == "" {
-		i.Result = ""
+		setResult(i, input)
 		return i
 	}
 
-	// Convert to lowercase for case-insensitive matching
-	inputLower := strings.ToLower(input)
-	startLower := strings.ToLower(start)
-	endLower := strings.ToLower(end)
-
-	// Find start position
-	startPos := 0
-	if startLower != "" {
-		startIdx := strings.Index(inputLower, startLower)
-		if startIdx == -1 {
-			// Start not found, return empty string
-			i.Result = ""
-			// Force Result to be used even if empty by setting Input to nil value
-			i.Input = ""
-			return i
-		}
-		startPos = startIdx + len(start)
-	}
+	cfg := newMatchConfig(true, opts)
 
-	// Check for overlapping start and end patterns
-	if endLower != "" && startPos > 0 {
-		// Calculate the end of the "start" pattern
-		startEndPos := strings.Index(inputLower, startLower) + len(startLower)
-
-		// Find the position of the "end" pattern
-		endStartPos := strings.Index(inputLower[startPos:], endLower)
-		if endStartPos == -1 {
-			// End not found after start position
-			i.Result = ""
-			i.Input = ""
-			return i
-		}
-
-		// If the starting position for searching the end pattern is at or before the end of start pattern,
-		// we have overlapping patterns (like in "startend" where "end" starts before "start" ends)
-		if startPos >= len(input) || startPos+endStartPos <= startEndPos {
-			i.Result = ""
-			i.Input = ""
-			return i
-		}
+	// Find start position, the text begins right after the start match
+	_, startPos := find(input, start, 0, cfg)
+	if startPos == -1 {
+		// Start not found, return empty string
+		setResult(i, "")
+		return i
 	}
 
-	// Find end position
+	// Find end position after the start match
 	endPos := len(input)
-	if endLower != "" {
-		endIdx := strings.Index(inputLower[startPos:], endLower)
-		if endIdx == -1 {
+	if end != "" {
+		endPos, _ = find(input, end, startPos, cfg)
+		if endPos == -1 {
 			// End not found, return empty string
-			i.Result = ""
-			// Force Result to be used even if empty by setting Input to nil value
-			i.Input = ""
+			setResult(i, "")
 			return i
 		}
-		endPos = startPos + endIdx
 	}
 
 	// Extract the substring
-	i.Result = input[startPos:endPos]
+	setResult(i, input[startPos:endPos])
 	return i
 }
 
@@ -202,21 +228,56 @@ func (i *input) Between(start, end string) StringManipulation {
 * "invalid" => false, sets error
  */
 func (i *input) Boolean() bool {
-	input := getInput(*i)
-	inputLower := strings.ToLower(input)
+	return i.BooleanWith(defaultBoolParser)
+}
 
-	if _, ok := falseMap[inputLower]; ok {
+/*
+* BooleanWith returns boolean value of the input using the vocabulary of the passed parser
+* it can be chained on function which return StringManipulation interface
+* @param parser *BoolParser, nil uses the default True and False vocabulary
+* @return bool
+* Note: If the string is not part of the vocabulary, it returns false and sets an error.
+* Example: BooleanWith(NewBoolParser([]string{"y"}, []string{"n"})) on "Y" => true
+ */
+func (i *input) BooleanWith(parser *BoolParser) bool {
+	if i.err != nil {
 		return false
 	}
 
-	if _, ok := trueMap[inputLower]; ok {
+	if parser == nil {
+		parser = defaultBoolParser
+	}
+	input := getInput(*i)
+	switch parser.State(input) {
+	case BoolTrue:
 		return true
+	case BoolFalse:
+		return false
 	}
 
-	i.err = errors.New(InvalidLogicalString)
+	i.err = newOpError("Boolean", input, nil, ErrInvalidBool)
 	return false // Return default value when error
 }
 
+/*
+* BooleanState returns the tri-state boolean value of the input: BoolTrue, BoolFalse or BoolUnknown
+* it can be chained on function which return StringManipulation interface
+* @param parser *BoolParser, nil uses the default True and False vocabulary
+* @return BoolState
+* Note: Unlike Boolean, an unknown value does not set an error.
+* Example: "yes" => BoolTrue, "maybe" => BoolUnknown
+ */
+func (i *input) BooleanState(parser *BoolParser) BoolState {
+	if i.err != nil {
+		return BoolUnknown
+	}
+
+	if parser == nil {
+		parser = defaultBoolParser
+	}
+	return parser.State(getInput(*i))
+}
+
 /*
  * CamelCase is variadic function that takes one Param slice of strings named rule
  * and it returns passed string in camel case form. Rule param helps to omit character
@@ -227,6 +288,10 @@ func (i *input) Boolean() bool {
  * Result : helloUser
  */
 func (i *input) CamelCase(rule ...string) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
 	input := getInput(*i)
 
 	// Handle null characters and control characters as word separators
@@ -240,8 +305,8 @@ func (i *input) CamelCase(rule ...string) StringManipulation {
 	// Process with standard caseHelper
 	words, err := caseHelper(input, true, rule...)
 	if err != nil {
-		i.err = err
-		i.Result = "" // Clear result on error
+		i.err = newOpError("CamelCase", input, rule, err)
+		setResult(i, "") // Clear result on error
 		return i
 	}
 
@@ -274,10 +339,27 @@ func (i *input) CamelCase(rule ...string) StringManipulation {
 		}
 	}
 
-	i.Result = result.String()
+	setResult(i, result.String())
 	return i
 }
 
+/*
+ * Clone returns an independent copy of the current value including its result and error.
+ * Transforms applied to the clone do not affect the original and vice versa, which makes
+ * it possible to branch a chain. A source passed with WithRand is not shared, as a *rand.Rand
+ * is not safe for concurrent use, so the clone shuffles with crypto/rand.
+ * @return StringManipulation
+ * Example: base := New(" Hello World ").Trim()
+ * base.Clone().SnakeCase().Get() => "Hello_World", base.Clone().KebabCase().Get() => "Hello-World"
+ */
+func (i *input) Clone() StringManipulation {
+	assertLive(*i)
+	c := inputPool.Get().(*input)
+	*c = *i
+	c.rand = nil
+	return c
+}
+
 /*
  * ContainsAll checks if all provided strings are present in the input string.
  * It can be chained on function which return StringManipulation interface.
@@ -288,6 +370,10 @@ func (i *input) CamelCase(rule ...string) StringManipulation {
  * "hello world" => ContainsAll("hello", "world", "foo") => false
  */
 func (i *input) ContainsAll(check ...string) bool {
+	if i.err != nil {
+		return false
+	}
+
 	input := getInput(*i)
 	for _, item := range check {
 		if !strings.Contains(input, item) {
@@ -308,17 +394,21 @@ func (i *input) ContainsAll(check ...string) bool {
 * Result : hello.user
  */
 func (i *input) Delimited(delimiter string, rule ...string) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
 	input := getInput(*i)
 	if strings.TrimSpace(delimiter) == "" {
 		delimiter = "."
 	}
 	words, err := caseHelper(input, false, rule...)
 	if err != nil {
-		i.err = err
-		i.Result = ""
+		i.err = newOpError("Delimited", input, rule, err)
+		setResult(i, "")
 		return i
 	}
-	i.Result = strings.Join(words, delimiter)
+	setResult(i, strings.Join(words, delimiter))
 	return i
 }
 
@@ -329,6 +419,7 @@ func (i *input) Delimited(delimiter string, rule ...string) StringManipulation {
 * Note: If no error occurred, it returns nil.
  */
 func (i *input) Error() error {
+	assertLive(*i)
 	return i.err
 }
 
@@ -343,14 +434,18 @@ func (i *input) Error() error {
 * "hello world" => First(-5) => error
  */
 func (i *input) First(length int) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	input = strings.ReplaceAll(input, " ", "")
 	if length < 0 {
-		i.err = errors.New("length cannot be negative")
+		i.err = newOpError("First", input, length, ErrNegativeLength)
 		return ""
 	}
 	if len(input) < length {
-		i.err = errors.New(LengthError)
+		i.err = newOpError("First", input, length, ErrLength)
 		return ""
 	}
 	return input[0:length]
@@ -366,6 +461,18 @@ func (i *input) Get() string {
 	return getInput(*i)
 }
 
+/*
+* GetE returns the result string together with the error of the chain.
+* It can be chained on function which return StringManipulation interface.
+* @return string
+* @return error
+* Note: If there was an error during string manipulation, it returns an empty string and the error.
+* Example: New("hello").SnakeCase("%").GetE() => "", ErrOddRule
+ */
+func (i *input) GetE() (string, error) {
+	return getInput(*i), i.err
+}
+
 /*
 * KebabCase is variadic function that takes one Param slice of strings named rule
 * and it returns passed string in kebab case form. Rule param helps to omit character
@@ -379,14 +486,18 @@ func (i *input) Get() string {
 * "hello world" => KebabCase("-") => "hello-world"
  */
 func (i *input) KebabCase(rule ...string) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
 	input := getInput(*i)
 	words, err := caseHelper(input, false, rule...)
 	if err != nil {
-		i.err = err
-		i.Result = ""
+		i.err = newOpError("KebabCase", input, rule, err)
+		setResult(i, "")
 		return i
 	}
-	i.Result = strings.Join(words, "-")
+	setResult(i, strings.Join(words, "-"))
 	return i
 }
 
@@ -398,15 +509,19 @@ func (i *input) KebabCase(rule ...string) StringManipulation {
 * Note: If length is negative or greater than input length, it returns an error.
  */
 func (i *input) Last(length int) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	input = strings.ReplaceAll(input, " ", "")
 	if length < 0 {
-		i.err = errors.New("length cannot be negative")
+		i.err = newOpError("Last", input, length, ErrNegativeLength)
 		return ""
 	}
 	inputLen := len(input)
 	if inputLen < length {
-		i.err = errors.New(LengthError)
+		i.err = newOpError("Last", input, length, ErrLength)
 		return ""
 	}
 	start := inputLen - length
@@ -421,6 +536,10 @@ func (i *input) Last(length int) string {
 * Example: "Hello World" => LcFirst() => "hello World"
  */
 func (i *input) LcFirst() string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	if input == "" {
 		return ""
@@ -439,6 +558,10 @@ func (i *input) LcFirst() string {
 * Example: "hello\nworld" => Lines() => []string{"hello", "world"}
  */
 func (i *input) Lines() []string {
+	if i.err != nil {
+		return []string{}
+	}
+
 	input := getInput(*i)
 	if input == "" {
 		return []string{}
@@ -459,21 +582,59 @@ func (i *input) Lines() []string {
 	return result
 }
 
+/*
+* MustGet returns the result string and panics if there was an error during string manipulation.
+* It is intended for values which are known to be valid, like constants in tests or initialization code.
+* @return string
+* Example: New("hello world").SnakeCase().MustGet() => "hello_world"
+ */
+func (i *input) MustGet() string {
+	if i.err != nil {
+		panic(i.err)
+	}
+	return getInput(*i)
+}
+
 /*
 * New is a constructor function that creates a new input object
 * and initializes it with the provided string value.
 * It returns a StringManipulation interface.
 * @param val string
+* @param opts ...Option e.g. WithRand
 * @return StringManipulation
  */
-func New(val string) StringManipulation {
+func New(val string, opts ...Option) StringManipulation {
 	i := inputPool.Get().(*input)
 	i.Input = val
 	i.Result = ""
+	i.hasResult = false
 	i.err = nil // Reset error
+	i.released = false
+	i.rand = nil
+	i.comparer = Comparer{}
+	for _, opt := range opts {
+		opt(i)
+	}
 	return i
 }
 
+// Option configures a StringManipulation created by New
+type Option func(*input)
+
+/*
+* WithRand makes Shuffle use r instead of the crypto backed default, so the result is
+* reproducible for a seeded r. A *rand.Rand is not safe for concurrent use, do not
+* share r between goroutines.
+* @param r *rand.Rand
+* @return Option
+* Example: New("hello", WithRand(rand.New(rand.NewSource(1)))).Shuffle() => the same permutation on every run
+ */
+func WithRand(r *rand.Rand) Option {
+	return func(i *input) {
+		i.rand = r
+	}
+}
+
 /*
 * Pad takes three params length, with, and padType.
 * It returns a string padded to the specified length with the specified character.
@@ -487,6 +648,10 @@ func New(val string) StringManipulation {
 * Example: "hello" => Pad(10, "*", "right") => "hello*****"
  */
 func (i *input) Pad(length int, with, padType string) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	inputLength := len(input)
 
@@ -538,12 +703,16 @@ func (i *input) Pad(length int, with, padType string) string {
 * Example: "hello world" => PascalCase() => "HelloWorld"
  */
 func (i *input) PascalCase(rule ...string) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
 	input := getInput(*i)
 	// removing excess space
 	words, err := caseHelper(input, true, rule...)
 	if err != nil {
-		i.err = err
-		i.Result = "" // Clear result on error
+		i.err = newOpError("PascalCase", input, rule, err)
+		setResult(i, "") // Clear result on error
 		return i
 	}
 
@@ -610,7 +779,7 @@ func (i *input) PascalCase(rule ...string) StringManipulation {
 		result.WriteString(processed)
 	}
 
-	i.Result = result.String()
+	setResult(i, result.String())
 	return i
 }
 
@@ -624,6 +793,10 @@ func (i *input) PascalCase(rule ...string) StringManipulation {
 * "world" => Prefix("hello") => "helloworld"
  */
 func (i *input) Prefix(with string) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	if strings.HasPrefix(input, with) {
 		return input
@@ -640,6 +813,10 @@ func (i *input) Prefix(with string) string {
 * Example: "hello@world!" => RemoveSpecialCharacter() => "helloworld"
  */
 func (i *input) RemoveSpecialCharacter() string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	var result strings.Builder
 	result.Grow(len(input))
@@ -657,13 +834,25 @@ func (i *input) RemoveSpecialCharacter() string {
 * Release releases the input object back to the pool
 * and clears the input and result fields.
 * It can be used to reset the object for future use.
-* Note: This method should be called when the input object is no longer needed.
-* It is important to call this method to avoid memory leaks.
+* Note: Release is optional, objects that are never released are simply garbage collected.
+* Once released the value must not be used again, because the pool may hand the same
+* object to another caller (possibly on another goroutine) through New.
+* Build with the stringydebug tag to turn use after Release and double Release into panics.
+* Example: str := stringy.New("hello"); defer str.Release()
  */
 func (i *input) Release() {
+	assertLive(*i)
 	i.Input = ""
 	i.Result = ""
+	i.hasResult = false
 	i.err = nil // Clear error
+	i.rand = nil
+	i.comparer = Comparer{}
+	if debugLifecycle {
+		// keep released objects out of the pool so stale references stay detectable
+		i.released = true
+		return
+	}
 	inputPool.Put(i)
 }
 
@@ -672,15 +861,22 @@ func (i *input) Release() {
 * it return string by searching search sub string and replacing it
 * with replace substring on first occurrence
 * it can be chained on function which return StringManipulation interface
+* Matching is case-insensitive by default, see MatchOption for CaseSensitive, WholeWord and PreserveCase.
 * @param search string substring to search for
 * @param replace string replacement string
+* @param opts ...MatchOption
 * @return string
 * Note: If the input string is empty, it returns an empty string.
 * Example: "hello world" => ReplaceFirst("world", "everyone") => "hello everyone"
+* "Color me" => ReplaceFirst("color", "colour", PreserveCase) => "Colour me"
  */
-func (i *input) ReplaceFirst(search, replace string) string {
+func (i *input) ReplaceFirst(search, replace string, opts ...MatchOption) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
-	return replaceStr(input, search, replace, First)
+	return replaceStr(input, search, replace, First, newMatchConfig(true, opts))
 }
 
 /*
@@ -688,15 +884,21 @@ func (i *input) ReplaceFirst(search, replace string) string {
 * it return string by searching search sub string and replacing it
 * with replace substring on last occurrence
 * it can be chained on function which return StringManipulation interface
+* Matching is case-insensitive by default, see MatchOption for CaseSensitive, WholeWord and PreserveCase.
 * @param search string substring to search for
 * @param replace string replacement string
+* @param opts ...MatchOption
 * @return string
 * Note: If the input string is empty, it returns an empty string.
 * Example: "hello world world" => ReplaceLast("world", "everyone") => "hello world everyone"
  */
-func (i *input) ReplaceLast(search, replace string) string {
+func (i *input) ReplaceLast(search, replace string, opts ...MatchOption) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
-	return replaceStr(input, search, replace, Last)
+	return replaceStr(input, search, replace, Last, newMatchConfig(true, opts))
 }
 
 /*
@@ -707,6 +909,10 @@ func (i *input) ReplaceLast(search, replace string) string {
 * Example: "hello world" => Reverse() => "dlrow olleh"
  */
 func (i *input) Reverse() string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 
 	// Special handling for TestN format in the concurrency test
@@ -763,8 +969,8 @@ func (i *input) SentenceCase(rule ...string) StringManipulation {
 	// Use caseHelper to identify word boundaries
 	words, err := caseHelper(input, false, rule...)
 	if err != nil {
-		i.err = err
-		i.Result = ""
+		i.err = newOpError("SentenceCase", input, rule, err)
+		setResult(i, "")
 		return i
 	}
 
@@ -789,27 +995,29 @@ func (i *input) SentenceCase(rule ...string) StringManipulation {
 		}
 	}
 
-	i.Result = strings.Join(words, " ")
+	setResult(i, strings.Join(words, " "))
 	return i
 }
 
 /*
 * Shuffle takes the input string and shuffles its characters randomly.
+* Characters are grapheme clusters, so combining marks, emoji sequences and flags stay intact.
+* The randomness comes from crypto/rand unless New was called with WithRand.
 * It can be chained on function which return StringManipulation interface.
 * @return string
 * Note: If the input string is empty, it returns an empty string.
 * Example: "hello" => Shuffle() => "oellh" (random output)
  */
 func (i *input) Shuffle() string {
-	input := getInput(*i)
-
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	if i.err != nil {
+		return ""
+	}
 
-	inRune := []rune(input)
-	r.Shuffle(len(inRune), func(i, j int) {
-		inRune[i], inRune[j] = inRune[j], inRune[i]
-	})
-	return string(inRune)
+	r := i.rand
+	if r == nil {
+		r = rand.New(cryptoSource{})
+	}
+	return shuffleGraphemes(getInput(*i), r)
 }
 
 /*
@@ -831,8 +1039,7 @@ func (i *input) SnakeCase(rule ...string) StringManipulation {
 	if strings.TrimFunc(input, func(r rune) bool {
 		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
 	}) == "" {
-		i.Result = ""
-		i.Input = ""
+		setResult(i, "")
 		return i
 	}
 
@@ -870,9 +1077,8 @@ func (i *input) SnakeCase(rule ...string) StringManipulation {
 
 	words, err := caseHelper(preprocessed.String(), false, rule...)
 	if err != nil {
-		i.err = err
-		i.Result = ""
-		i.Input = ""
+		i.err = newOpError("SnakeCase", input, rule, err)
+		setResult(i, "")
 		return i
 	}
 
@@ -886,8 +1092,7 @@ func (i *input) SnakeCase(rule ...string) StringManipulation {
 
 	// Handling edge cases
 	if len(filteredWords) == 0 {
-		i.Result = ""
-		i.Input = ""
+		setResult(i, "")
 		return i
 	}
 
@@ -903,7 +1108,7 @@ func (i *input) SnakeCase(rule ...string) StringManipulation {
 		result.WriteString(word)
 	}
 
-	i.Result = result.String()
+	setResult(i, result.String())
 	return i
 }
 
@@ -917,6 +1122,10 @@ func (i *input) SnakeCase(rule ...string) StringManipulation {
 * "hello" => Suffix("!") => "hello!"
  */
 func (i *input) Suffix(with string) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	if strings.HasSuffix(input, with) {
 		return input
@@ -935,6 +1144,10 @@ func (i *input) Suffix(with string) string {
 * "hello" => Surround("world") => "worldhelloworld"
  */
 func (i *input) Surround(with string) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	return with + input + with
 }
@@ -951,6 +1164,10 @@ func (i *input) Surround(with string) string {
 * "hello world" => Tease(20, "...") => "hello world..."
  */
 func (i *input) Tease(length int, indicator string) string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	if input == "" || len(input) < length {
 		return input
@@ -970,6 +1187,10 @@ func (i *input) Tease(length int, indicator string) string {
 * Example: "hello world" => Title() => "Hello World"
  */
 func (i *input) Title() string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	wordArray := strings.Split(input, " ")
 	for i, word := range wordArray {
@@ -1011,10 +1232,10 @@ func (i *input) Trim(cutset ...string) StringManipulation {
 
 	if len(cutset) == 0 {
 		// Default: trim whitespace
-		i.Result = strings.TrimSpace(input)
+		setResult(i, strings.TrimSpace(input))
 	} else {
 		// Trim specified characters
-		i.Result = strings.Trim(input, cutset[0])
+		setResult(i, strings.Trim(input, cutset[0]))
 	}
 
 	return i
@@ -1043,6 +1264,10 @@ func (i *input) ToUpper() string {
 * Example: "hello world" => UcFirst() => "Hello world"
  */
 func (i *input) UcFirst() string {
+	if i.err != nil {
+		return ""
+	}
+
 	input := getInput(*i)
 	if input == "" {
 		return ""
@@ -1062,6 +1287,10 @@ func (i *input) UcFirst() string {
 * "hello world" => WordCount() => 2
  */
 func (i *input) WordCount() int {
+	if i.err != nil {
+		return 0
+	}
+
 	input := getInput(*i)
 	if input == "" {
 		return 0
@@ -1090,11 +1319,11 @@ func (i *input) TruncateWords(count int, suffix string) StringManipulation {
 	words := strings.Fields(input)
 
 	if len(words) <= count {
-		i.Result = input
+		setResult(i, input)
 		return i
 	}
 
-	i.Result = strings.Join(words[:count], " ") + suffix
+	setResult(i, strings.Join(words[:count], " ")+suffix)
 	return i
 }
 
@@ -1104,6 +1333,10 @@ func (i *input) TruncateWords(count int, suffix string) StringManipulation {
 * @return bool
  */
 func (i *input) IsEmpty() bool {
+	if i.err != nil {
+		return false
+	}
+
 	input := getInput(*i)
 	return strings.TrimSpace(input) == ""
 }
@@ -1121,9 +1354,7 @@ func (i *input) Substring(start, end int) StringManipulation {
 	}
 
 	if start == end {
-		i.Result = ""
-		// Important: Force Result to be used even if empty by setting Input to empty
-		i.Input = ""
+		setResult(i, "")
 		return i
 	}
 
@@ -1141,14 +1372,13 @@ func (i *input) Substring(start, end int) StringManipulation {
 
 	// Handle invalid ranges
 	if start > end {
-		i.err = errors.New("start position cannot be greater than end position")
-		i.Result = ""
-		i.Input = "" // Force Result to be used even if empty
+		i.err = newOpError("Substring", input, []int{start, end}, ErrInvalidRange)
+		setResult(i, "")
 		return i
 	}
 
 	// Extract the substring
-	i.Result = string(runes[start:end])
+	setResult(i, string(runes[start:end]))
 	return i
 }
 
@@ -1191,9 +1421,9 @@ func (i *input) SlugifyWithCount(count int) StringManipulation {
 
 	// If count is greater than 0, append it
 	if count > 0 {
-		i.Result = fmt.Sprintf("%s-%d", tempResult, count)
+		setResult(i, fmt.Sprintf("%s-%d", tempResult, count))
 	} else {
-		i.Result = tempResult
+		setResult(i, tempResult)
 	}
 
 	return i
@@ -1208,6 +1438,10 @@ func (i *input) SlugifyWithCount(count int) StringManipulation {
 * Example: "hello world" => Contains("world") => true
  */
 func (i *input) Contains(substring string) bool {
+	if i.err != nil {
+		return false
+	}
+
 	input := getInput(*i)
 	return strings.Contains(input, substring)
 }
@@ -1216,18 +1450,25 @@ func (i *input) Contains(substring string) bool {
 * ReplaceAll replaces all occurrences of a substring in the input string
 * with a specified replacement string.
 * it can be chained on function which return StringManipulation interface
+* Matching is case sensitive by default, see MatchOption for CaseInsensitive, WholeWord and PreserveCase.
 * @param search string substring to search for
 * @param replace string replacement string
+* @param opts ...MatchOption
 * @return StringManipulation
 * Note: If the input string is empty, it returns an empty string.
 * Example: "hello world" => ReplaceAll("world", "everyone") => "hello everyone"
+* "Color or COLOR" => ReplaceAll("color", "colour", PreserveCase) => "Colour or COLOUR"
  */
-func (i *input) ReplaceAll(search, replace string) StringManipulation {
+func (i *input) ReplaceAll(search, replace string, opts ...MatchOption) StringManipulation {
 	if i.err != nil {
 		return i
 	}
 
 	input := getInput(*i)
-	i.Result = strings.ReplaceAll(input, search, replace)
+	if len(opts) == 0 {
+		setResult(i, strings.ReplaceAll(input, search, replace))
+		return i
+	}
+	setResult(i, NewReplacer(map[string]string{search: replace}, opts...).Replace(input))
 	return i
 }
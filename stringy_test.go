@@ -3,6 +3,7 @@ package stringy
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"strings"
 	"sync"
 	"testing"
@@ -178,6 +179,22 @@ func TestInput_PascalCaseOddRuleError(t *testing.T) {
 	}
 }
 
+// Test Clone - branching a chain
+func TestInput_Clone(t *testing.T) {
+	base := New(" Hello World ").Trim()
+	snake := base.Clone().SnakeCase().Get()
+	kebab := base.Clone().KebabCase().Get()
+	if snake != "Hello_World" {
+		t.Errorf("Expected: %s but got: %s", "Hello_World", snake)
+	}
+	if kebab != "Hello-World" {
+		t.Errorf("Expected: %s but got: %s", "Hello-World", kebab)
+	}
+	if val := base.Get(); val != "Hello World" {
+		t.Errorf("Expected base to be unchanged but got: %s", val)
+	}
+}
+
 // Test ContainsAll
 func TestInput_ContainsAll(t *testing.T) {
 	contains := New("hello mam how are you??")
@@ -425,6 +442,56 @@ func TestInput_Shuffle(t *testing.T) {
 	}
 }
 
+// Test Shuffle with an injected rand source
+func TestInput_ShuffleWithRand(t *testing.T) {
+	a := New("hello world", WithRand(rand.New(rand.NewSource(7)))).Shuffle()
+	b := New("hello world", WithRand(rand.New(rand.NewSource(7)))).Shuffle()
+	if a != b {
+		t.Errorf("Expected the same permutation for the same seed but got: %s and %s", a, b)
+	}
+	if c := New("hello world", WithRand(rand.New(rand.NewSource(7)))).ShuffleWith(rand.New(rand.NewSource(7))); c != a {
+		t.Errorf("Expected ShuffleWith to match WithRand but got: %s and %s", c, a)
+	}
+
+	// pooled values must not keep the rand source of a previous use
+	sm := New("abc", WithRand(rand.New(rand.NewSource(1))))
+	sm.Release()
+	if fresh := New("abc").(*input); fresh.rand != nil {
+		t.Errorf("Expected New to reset the rand source")
+	}
+
+	// a clone must not advance the injected source of the original
+	base := New("hello world", WithRand(rand.New(rand.NewSource(7))))
+	clone := base.Clone()
+	if clone.(*input).rand != nil {
+		t.Errorf("Expected Clone not to share the rand source")
+	}
+	clone.Shuffle()
+	if val := base.Shuffle(); val != a {
+		t.Errorf("Expected the original sequence after shuffling a clone but got: %s and %s", val, a)
+	}
+}
+
+// Test Shuffle keeps grapheme clusters intact
+func TestInput_ShuffleGraphemes(t *testing.T) {
+	input := "e\u0301👍🏽🇩🇪👨‍👩‍👧a\r\n"
+	expected := []string{"e\u0301", "👍🏽", "🇩🇪", "👨‍👩‍👧", "a", "\r\n"}
+	for seed := int64(0); seed < 20; seed++ {
+		val := New(input, WithRand(rand.New(rand.NewSource(seed)))).Shuffle()
+		if len(val) != len(input) {
+			t.Fatalf("Expected a permutation of the input but got: %q", val)
+		}
+		for _, cluster := range expected {
+			if !strings.Contains(val, cluster) {
+				t.Errorf("Expected %q to be kept intact in %q", cluster, val)
+			}
+		}
+	}
+	if val := New("🇩🇪🇫🇷").Shuffle(); val != "🇩🇪🇫🇷" && val != "🇫🇷🇩🇪" {
+		t.Errorf("Expected flags to be kept intact but got: %q", val)
+	}
+}
+
 // Test SnakeCase
 func TestInput_SnakeCase(t *testing.T) {
 	str := New("SnakeCase this-complicated___string@@")
@@ -632,8 +699,8 @@ func TestInput_Error(t *testing.T) {
 	if str.Error() == nil {
 		t.Errorf("Expected error but got nil")
 	}
-	if str.Error().Error() != InvalidLogicalString {
-		t.Errorf("Expected error message '%s' but got: %s", InvalidLogicalString, str.Error().Error())
+	if !errors.Is(str.Error(), ErrInvalidBool) {
+		t.Errorf("Expected error '%v' but got: %v", ErrInvalidBool, str.Error())
 	}
 
 	// Test that Error() is reset when using New()
@@ -658,6 +725,26 @@ func TestInput_Release(t *testing.T) {
 	}
 }
 
+// Test Release through the StringManipulation interface
+func TestInput_ReleaseInterface(t *testing.T) {
+	str := New("hello world")
+	if val := str.KebabCase().Get(); val != "hello-world" {
+		t.Errorf("Expected: %s but got: %s", "hello-world", val)
+	}
+	str.Release()
+
+	if debugLifecycle {
+		return
+	}
+	str = New("fresh")
+	if val := str.Get(); val != "fresh" {
+		t.Errorf("Expected: %s but got: %s", "fresh", val)
+	}
+	if str.Error() != nil {
+		t.Errorf("Expected no error but got: %v", str.Error())
+	}
+}
+
 // Test method chaining with errors
 func TestInput_MethodChainingWithErrors(t *testing.T) {
 	// Test that an error in one method is preserved through a chain
@@ -972,6 +1059,32 @@ func TestInput_BetweenEdgeCases(t *testing.T) {
 	}
 }
 
+// Test Between and ReplaceFirst/ReplaceLast with characters whose lowercase has a different byte length
+func TestInput_UnicodeCaseFolding(t *testing.T) {
+	testCases := []struct {
+		name     string
+		run      func() string
+		expected string
+	}{
+		{"BetweenKelvin", func() string { return New("temp \u212a5 k").Between("k", "k").Get() }, "5 "},
+		{"BetweenDottedI", func() string { return New("İstanbul [x] İzmir").Between("[", "İzmir").Get() }, "x] "},
+		{"BetweenSharpS", func() string { return New("STRAẞE und Straße").Between("straße", "straße").Get() }, " und "},
+		{"BetweenCaseSensitive", func() string { return New("Start a start b END c end").Between("start", "end", CaseSensitive).Get() }, " b END c "},
+		{"BetweenWholeWord", func() string { return New("category: cat [x] dog").Between("cat", "dog", WholeWord).Get() }, " [x] "},
+		{"FirstKelvin", func() string { return New("\u212aelvin and kelvin").ReplaceFirst("kelvin", "K") }, "K and kelvin"},
+		{"LastDottedI", func() string { return New("İİ i İ").ReplaceLast("İ", "I") }, "İİ i I"},
+		{"FirstNoPanic", func() string { return New("ȺȺȺ x").ReplaceFirst("x", "y") }, "ȺȺȺ y"},
+		{"LastCaseSensitive", func() string { return New("Go go GO").ReplaceLast("go", "Rust", CaseSensitive) }, "Go Rust GO"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if val := tc.run(); val != tc.expected {
+				t.Errorf("Expected: %q but got: %q", tc.expected, val)
+			}
+		})
+	}
+}
+
 // Additional test for method chaining
 func TestInput_MethodChaining(t *testing.T) {
 	str := New("this is a TEST string")
@@ -1641,3 +1754,89 @@ func TestInput_ReplaceAll(t *testing.T) {
 		})
 	}
 }
+
+// Test chaining on an empty intermediate result - the next step must not see the original input
+func TestInput_EmptyIntermediateResult(t *testing.T) {
+	producers := []struct {
+		name  string
+		chain func() StringManipulation
+	}{
+		{"Acronym", func() StringManipulation { return New("   ").Acronym() }},
+		{"Between", func() StringManipulation { return New("hello world").Between("foo", "bar") }},
+		{"CamelCase", func() StringManipulation { return New("---").CamelCase() }},
+		{"Delimited", func() StringManipulation { return New("...").Delimited("-") }},
+		{"KebabCase", func() StringManipulation { return New("___").KebabCase() }},
+		{"PascalCase", func() StringManipulation { return New(" . ").PascalCase() }},
+		{"ReplaceAll", func() StringManipulation { return New("abc").ReplaceAll("abc", "") }},
+		{"SentenceCase", func() StringManipulation { return New("-_-").SentenceCase() }},
+		{"SnakeCase", func() StringManipulation { return New("!!!").SnakeCase() }},
+		{"Substring", func() StringManipulation { return New("hello").Substring(2, 2) }},
+		{"Trim", func() StringManipulation { return New("   ").Trim() }},
+		{"TruncateWords", func() StringManipulation { return New("hello").ReplaceAll("hello", "").TruncateWords(0, "") }},
+	}
+
+	for _, p := range producers {
+		t.Run(p.name, func(t *testing.T) {
+			if val := p.chain().Get(); val != "" {
+				t.Errorf("Expected empty result but got: %q", val)
+			}
+			if val := p.chain().Between("", "").Get(); val != "" {
+				t.Errorf("Between - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().CamelCase().Get(); val != "" {
+				t.Errorf("CamelCase - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().Clone().Get(); val != "" {
+				t.Errorf("Clone - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().Delimited(".").Get(); val != "" {
+				t.Errorf("Delimited - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().KebabCase().Get(); val != "" {
+				t.Errorf("KebabCase - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().PascalCase().Get(); val != "" {
+				t.Errorf("PascalCase - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().ReplaceAll("x", "y").Get(); val != "" {
+				t.Errorf("ReplaceAll - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().SentenceCase().Get(); val != "" {
+				t.Errorf("SentenceCase - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().SlugifyWithCount(0).Get(); val != "" {
+				t.Errorf("SlugifyWithCount - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().SnakeCase().Get(); val != "" {
+				t.Errorf("SnakeCase - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().Substring(0, 3).Get(); val != "" {
+				t.Errorf("Substring - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().Trim().Get(); val != "" {
+				t.Errorf("Trim - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().TruncateWords(1, "...").Get(); val != "" {
+				t.Errorf("TruncateWords - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().Acronym().Get(); val != "" {
+				t.Errorf("Acronym - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().ToUpper(); val != "" {
+				t.Errorf("ToUpper - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().Reverse(); val != "" {
+				t.Errorf("Reverse - Expected empty result but got: %q", val)
+			}
+			if val := p.chain().WordCount(); val != 0 {
+				t.Errorf("WordCount - Expected 0 but got: %d", val)
+			}
+			if val := p.chain().Pad(3, "*", Left); val != "***" {
+				t.Errorf("Pad - Expected *** but got: %q", val)
+			}
+			if !p.chain().IsEmpty() {
+				t.Errorf("IsEmpty - Expected true")
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package stringy
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Suggestion is a candidate returned by Suggester.Suggest together with its edit distance
+type Suggestion struct {
+	Value    string
+	Distance int
+}
+
+// bkNode is a node of the BK-tree used by Suggester
+type bkNode struct {
+	key      string   // compared form of the candidates
+	values   []string // candidates sharing the key
+	children map[int]*bkNode
+}
+
+/*
+ * Suggester finds the candidates closest to a query, e.g. to suggest the subcommand or
+ * configuration key the user meant. Candidates are indexed in a BK-tree on their
+ * Levenshtein distance so large candidate sets do not need to be scanned completely.
+ * A Suggester is safe for concurrent use.
+ */
+type Suggester struct {
+	mu         sync.RWMutex
+	root       *bkNode
+	normalized bool
+}
+
+/*
+ * NewSuggester creates a Suggester for candidates. When normalized is true, queries and
+ * candidates are compared by their normalized form: the words found by SnakeCase,
+ * lowercased and joined without separator, so "user-id", "user_id" and "UserID" are equal.
+ * @param candidates []string
+ * @param normalized bool
+ * @return *Suggester
+ */
+func NewSuggester(candidates []string, normalized bool) *Suggester {
+	s := &Suggester{normalized: normalized}
+	for _, candidate := range candidates {
+		s.Add(candidate)
+	}
+	return s
+}
+
+/*
+ * normalizeKey is a helper function to build the form used to compare candidates.
+ * @param val string
+ * @param normalized bool
+ * @return string
+ * Example: normalizeKey("UserID", true) => "userid"
+ */
+func normalizeKey(val string, normalized bool) string {
+	if !normalized {
+		return val
+	}
+	return strings.ReplaceAll(Of(val).SnakeCase().ToLower(), "_", "")
+}
+
+/*
+ * Add adds a candidate to the index.
+ * @param candidate string
+ */
+func (s *Suggester) Add(candidate string) {
+	key := normalizeKey(candidate, s.normalized)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.root == nil {
+		s.root = &bkNode{key: key, values: []string{candidate}}
+		return
+	}
+	node := s.root
+	for {
+		distance := Levenshtein(key, node.key)
+		if distance == 0 {
+			node.values = append(node.values, candidate)
+			return
+		}
+		child, ok := node.children[distance]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[int]*bkNode)
+			}
+			node.children[distance] = &bkNode{key: key, values: []string{candidate}}
+			return
+		}
+		node = child
+	}
+}
+
+/*
+ * Suggest returns the candidates within maxDistance edits of query, closest first.
+ * Candidates with the same distance are ranked by Jaro-Winkler similarity, which favours
+ * a common prefix, and then alphabetically. A nil Suggester has no candidates.
+ * @param query string
+ * @param maxDistance int
+ * @return []Suggestion
+ * Example: NewSuggester([]string{"install", "uninstall", "list"}, false).Suggest("instal", 2)
+ * => [{install 1}]
+ */
+func (s *Suggester) Suggest(query string, maxDistance int) []Suggestion {
+	if s == nil {
+		return nil
+	}
+	key := normalizeKey(query, s.normalized)
+	var suggestions []Suggestion
+	similarity := map[string]float64{}
+
+	s.mu.RLock()
+	if s.root != nil && maxDistance >= 0 {
+		stack := []*bkNode{s.root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			distance := Levenshtein(key, node.key)
+			if distance <= maxDistance {
+				for _, value := range node.values {
+					suggestions = append(suggestions, Suggestion{Value: value, Distance: distance})
+					similarity[value] = JaroWinkler(key, node.key)
+				}
+			}
+			// by the triangle inequality only children within maxDistance of distance can match
+			for d, child := range node.children {
+				if d >= distance-maxDistance && d <= distance+maxDistance {
+					stack = append(stack, child)
+				}
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(suggestions, func(a, b int) bool {
+		if suggestions[a].Distance != suggestions[b].Distance {
+			return suggestions[a].Distance < suggestions[b].Distance
+		}
+		simA, simB := similarity[suggestions[a].Value], similarity[suggestions[b].Value]
+		if simA != simB {
+			return simA > simB
+		}
+		return suggestions[a].Value < suggestions[b].Value
+	})
+	return suggestions
+}
+
+/*
+ * Closest returns the best suggestion for query within maxDistance edits.
+ * @param query string
+ * @param maxDistance int
+ * @return string
+ * @return bool false if no candidate is close enough
+ */
+func (s *Suggester) Closest(query string, maxDistance int) (string, bool) {
+	suggestions := s.Suggest(query, maxDistance)
+	if len(suggestions) == 0 {
+		return "", false
+	}
+	return suggestions[0].Value, true
+}
+
+/*
+ * suggestionValues is a helper function to return the values of suggestions.
+ * @param suggestions []Suggestion
+ * @return []string
+ */
+func suggestionValues(suggestions []Suggestion) []string {
+	values := make([]string, len(suggestions))
+	for idx, suggestion := range suggestions {
+		values[idx] = suggestion.Value
+	}
+	return values
+}
+
+/*
+* Suggest returns the candidates within maxDistance edits of the input, closest first
+* it can be chained on function which return StringManipulation interface
+* @param candidates []string
+* @param maxDistance int
+* @return []string
+* Note: For large or reused candidate sets create a Suggester once and use SuggestFrom.
+* Example: "stauts" => Suggest([]string{"status", "stash", "list"}, 2) => []string{"status"}
+ */
+func (i *input) Suggest(candidates []string, maxDistance int) []string {
+	if i.err != nil {
+		return []string{}
+	}
+
+	return suggestionValues(NewSuggester(candidates, false).Suggest(getInput(*i), maxDistance))
+}
+
+/*
+* SuggestFrom returns the candidates of suggester within maxDistance edits of the input, closest first
+* it can be chained on function which return StringManipulation interface
+* @param suggester *Suggester, nil suggests nothing
+* @param maxDistance int
+* @return []string
+* Example: "user-id" => SuggestFrom(NewSuggester([]string{"UserID", "UserName"}, true), 1) => []string{"UserID"}
+ */
+func (i *input) SuggestFrom(suggester *Suggester, maxDistance int) []string {
+	if i.err != nil {
+		return []string{}
+	}
+
+	return suggestionValues(suggester.Suggest(getInput(*i), maxDistance))
+}
@@ -0,0 +1,123 @@
+package stringy
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// Test Suggester ranking
+func TestSuggester_Suggest(t *testing.T) {
+	s := NewSuggester([]string{"install", "uninstall", "list", "info", "init", "inspect"}, false)
+
+	expected := []Suggestion{{"install", 1}}
+	if val := s.Suggest("instal", 1); !reflect.DeepEqual(val, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, val)
+	}
+
+	expected = []Suggestion{{"info", 1}, {"init", 2}, {"list", 3}}
+	if val := s.Suggest("inf", 3)[:3]; !reflect.DeepEqual(val, expected) {
+		t.Errorf("Expected: %v but got: %v", expected, val)
+	}
+
+	if val := s.Suggest("zzzzzz", 2); len(val) != 0 {
+		t.Errorf("Expected no suggestions but got: %v", val)
+	}
+	if val, ok := s.Closest("lsit", 2); val != "list" || !ok {
+		t.Errorf("Expected: list but got: %s, %v", val, ok)
+	}
+	if _, ok := s.Closest("zzzzzz", 1); ok {
+		t.Errorf("Expected no closest candidate")
+	}
+	if val := NewSuggester(nil, false).Suggest("x", 3); len(val) != 0 {
+		t.Errorf("Expected no suggestions from empty suggester but got: %v", val)
+	}
+}
+
+// Test Suggester on normalized forms
+func TestSuggester_Normalized(t *testing.T) {
+	s := NewSuggester([]string{"UserID", "UserName", "created_at", "updated-at"}, true)
+
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"user-id", "UserID"},
+		{"user_name", "UserName"},
+		{"CreatedAt", "created_at"},
+		{"updatedAt", "updated-at"},
+		{"usr_id", "UserID"},
+	}
+	for _, tc := range testCases {
+		if val, ok := s.Closest(tc.query, 1); val != tc.expected || !ok {
+			t.Errorf("Closest(%s) - Expected: %s but got: %s", tc.query, tc.expected, val)
+		}
+	}
+
+	raw := NewSuggester([]string{"UserID"}, false)
+	if _, ok := raw.Closest("user-id", 1); ok {
+		t.Errorf("Expected no match without normalization")
+	}
+}
+
+// Test the BK-tree against a linear scan on a larger candidate set
+func TestSuggester_Large(t *testing.T) {
+	var candidates []string
+	for i := 0; i < 2000; i++ {
+		candidates = append(candidates, fmt.Sprintf("key%dvalue", i))
+	}
+	s := NewSuggester(candidates, false)
+
+	query := "key1234valeu"
+	var expected []string
+	for _, c := range candidates {
+		if Levenshtein(query, c) <= 2 {
+			expected = append(expected, c)
+		}
+	}
+	got := s.Suggest(query, 2)
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d suggestions but got: %d", len(expected), len(got))
+	}
+	if got[0].Value != "key1234value" {
+		t.Errorf("Expected best suggestion: key1234value but got: %s", got[0].Value)
+	}
+}
+
+// Test concurrent Add and Suggest
+func TestSuggester_Concurrency(t *testing.T) {
+	s := NewSuggester([]string{"alpha"}, true)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func(id int) {
+			defer wg.Done()
+			s.Add(fmt.Sprintf("beta%d", id))
+		}(i)
+		go func() {
+			defer wg.Done()
+			if val, ok := s.Closest("alpah", 2); val != "alpha" || !ok {
+				t.Errorf("Expected: alpha but got: %s", val)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Test Suggest and SuggestFrom chain methods
+func TestInput_Suggest(t *testing.T) {
+	if val := New("stauts").Suggest([]string{"status", "stash", "list"}, 2); !reflect.DeepEqual(val, []string{"status"}) {
+		t.Errorf("Expected: [status] but got: %v", val)
+	}
+	s := NewSuggester([]string{"UserID", "UserName"}, true)
+	if val := New("user-id").SuggestFrom(s, 1); !reflect.DeepEqual(val, []string{"UserID"}) {
+		t.Errorf("Expected: [UserID] but got: %v", val)
+	}
+	if val := New("nothing").Suggest([]string{"status"}, 1); len(val) != 0 {
+		t.Errorf("Expected no suggestions but got: %v", val)
+	}
+	if val := New("status").SuggestFrom(nil, 1); !reflect.DeepEqual(val, []string{}) {
+		t.Errorf("Expected no suggestions from a nil suggester but got: %v", val)
+	}
+}
@@ -0,0 +1,534 @@
+package stringy
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+/*
+ * ValidationError describes why an input does not satisfy a validation rule. It is recorded
+ * by Validate wrapped in an *OpError and unwraps to ErrValidation.
+ * Example: "stringy: Validate(email): invalid email: missing @"
+ */
+type ValidationError struct {
+	Rule   string // name of the failed rule, e.g. "email"
+	Reason string // why the input does not satisfy the rule, e.g. "missing @"
+}
+
+// Error returns the failed rule together with the reason
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Rule, e.Reason)
+}
+
+// Unwrap returns ErrValidation
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+/*
+ * Rule is a named check which can be applied with Validate. The check returns the reason
+ * why the input is invalid, or an empty string if the input is valid.
+ * Example: Rule{Name: "even length", Check: func(s string) string { if len(s)%2 != 0 { return "odd length" }; return "" }}
+ */
+type Rule struct {
+	Name  string
+	Check func(input string) string
+}
+
+// Predefined rules for Validate, they match the Is predicates of StringManipulation
+var (
+	RuleEmail        = Rule{Name: "email", Check: checkEmail}
+	RuleURL          = Rule{Name: "url", Check: checkURL}
+	RuleIPv4         = Rule{Name: "ipv4", Check: checkIPv4}
+	RuleIPv6         = Rule{Name: "ipv6", Check: checkIPv6}
+	RuleHostname     = Rule{Name: "hostname", Check: checkHostname}
+	RuleNumeric      = Rule{Name: "numeric", Check: checkNumeric}
+	RuleAlpha        = Rule{Name: "alpha", Check: checkAlpha}
+	RuleAlphanumeric = Rule{Name: "alphanumeric", Check: checkAlphanumeric}
+	RuleASCII        = Rule{Name: "ascii", Check: checkASCII}
+	RuleHex          = Rule{Name: "hex", Check: checkHex}
+	RuleBase64       = Rule{Name: "base64", Check: checkBase64}
+	RuleJSON         = Rule{Name: "json", Check: checkJSON}
+)
+
+/*
+ * RuleUUID returns a rule accepting RFC 4122 UUIDs of the given version, 0 accepts every version.
+ * @param version int
+ * @return Rule
+ * Example: RuleUUID(4) => rule named "uuid v4"
+ */
+func RuleUUID(version int) Rule {
+	name := "uuid"
+	if version != 0 {
+		name = fmt.Sprintf("uuid v%d", version)
+	}
+	return Rule{Name: name, Check: func(input string) string {
+		return checkUUID(input, version)
+	}}
+}
+
+/*
+ * checkEmail is a helper function to validate an email address of the form local@domain.
+ * Quoted local parts and comments are not supported.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkEmail(input string) string {
+	at := strings.LastIndexByte(input, '@')
+	if at == -1 {
+		return "missing @"
+	}
+	local, domain := input[:at], input[at+1:]
+	if local == "" {
+		return "empty local part"
+	}
+	if len(local) > 64 {
+		return "local part longer than 64 characters"
+	}
+	if local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return "misplaced dot in local part"
+	}
+	for _, r := range local {
+		if r > unicode.MaxASCII || !(isAlphaNumericASCII(r) || strings.ContainsRune("!#$%&'*+-/=?^_`{|}~.", r)) {
+			return fmt.Sprintf("invalid character %q in local part", r)
+		}
+	}
+	if reason := checkHostname(domain); reason != "" {
+		return "invalid domain: " + reason
+	}
+	if !strings.Contains(strings.TrimSuffix(domain, "."), ".") {
+		return "domain without top level domain"
+	}
+	return ""
+}
+
+/*
+ * checkURL is a helper function to validate an absolute URL with a scheme and a host.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkURL(input string) string {
+	u, err := url.Parse(input)
+	if err != nil {
+		return "malformed url"
+	}
+	if u.Scheme == "" {
+		return "missing scheme"
+	}
+	if u.Host == "" {
+		return "missing host"
+	}
+	host := u.Hostname()
+	if strings.Contains(host, ":") {
+		if checkIPv6(host) != "" {
+			return "invalid host"
+		}
+	} else if checkIPv4(host) != "" && checkHostname(host) != "" {
+		return "invalid host"
+	}
+	return ""
+}
+
+/*
+ * checkUUID is a helper function to validate a UUID in its canonical 8-4-4-4-12 form.
+ * @param input string
+ * @param version int 0 for any version
+ * @return string reason, empty if valid
+ */
+func checkUUID(input string, version int) string {
+	if len(input) != 36 {
+		return "length must be 36"
+	}
+	for idx := 0; idx < len(input); idx++ {
+		if idx == 8 || idx == 13 || idx == 18 || idx == 23 {
+			if input[idx] != '-' {
+				return fmt.Sprintf("expected - at position %d", idx)
+			}
+		} else if !isHexDigit(input[idx]) {
+			return fmt.Sprintf("invalid character %q", input[idx])
+		}
+	}
+	if v := int(input[14] - '0'); version != 0 && v != version {
+		return fmt.Sprintf("version is %c, expected %d", input[14], version)
+	} else if v < 1 || v > 8 {
+		return fmt.Sprintf("unknown version %c", input[14])
+	}
+	if !strings.ContainsRune("89abAB", rune(input[19])) {
+		return "invalid variant"
+	}
+	return ""
+}
+
+/*
+ * checkIPv4 is a helper function to validate an IPv4 address in dotted decimal form.
+ * Leading zeros are rejected as they are ambiguous.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkIPv4(input string) string {
+	parts := strings.Split(input, ".")
+	if len(parts) != 4 {
+		return "expected 4 octets"
+	}
+	for _, part := range parts {
+		if part == "" || len(part) > 3 {
+			return fmt.Sprintf("invalid octet %q", part)
+		}
+		value := 0
+		for idx := 0; idx < len(part); idx++ {
+			if part[idx] < '0' || part[idx] > '9' {
+				return fmt.Sprintf("invalid octet %q", part)
+			}
+			value = value*10 + int(part[idx]-'0')
+		}
+		if len(part) > 1 && part[0] == '0' {
+			return fmt.Sprintf("leading zero in octet %q", part)
+		}
+		if value > 255 {
+			return fmt.Sprintf("octet %q greater than 255", part)
+		}
+	}
+	return ""
+}
+
+/*
+ * checkIPv6 is a helper function to validate an IPv6 address, zones are not supported.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkIPv6(input string) string {
+	if !strings.Contains(input, ":") || net.ParseIP(input) == nil {
+		return "malformed address"
+	}
+	return ""
+}
+
+/*
+ * checkHostname is a helper function to validate a hostname as of RFC 1123: labels of
+ * letters, digits and hyphens up to 63 characters, 253 characters in total.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkHostname(input string) string {
+	host := strings.TrimSuffix(input, ".")
+	if host == "" {
+		return "empty"
+	}
+	if len(host) > 253 {
+		return "longer than 253 characters"
+	}
+	for _, label := range strings.Split(host, ".") {
+		if label == "" {
+			return "empty label"
+		}
+		if len(label) > 63 {
+			return fmt.Sprintf("label %q longer than 63 characters", label)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Sprintf("label %q starts or ends with a hyphen", label)
+		}
+		for _, r := range label {
+			if r > unicode.MaxASCII || !(isAlphaNumericASCII(r) || r == '-') {
+				return fmt.Sprintf("invalid character %q", r)
+			}
+		}
+	}
+	return ""
+}
+
+/*
+ * checkNumeric is a helper function to validate a decimal number with an optional sign
+ * and an optional fraction, e.g. "-12.5".
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkNumeric(input string) string {
+	digits := strings.TrimLeft(input, "+-")
+	if len(input)-len(digits) > 1 {
+		return "more than one sign"
+	}
+	if strings.Count(digits, ".") > 1 {
+		return "more than one decimal point"
+	}
+	if strings.Trim(digits, ".") == "" {
+		return "no digits"
+	}
+	for _, r := range digits {
+		if r != '.' && (r < '0' || r > '9') {
+			return fmt.Sprintf("invalid character %q", r)
+		}
+	}
+	return ""
+}
+
+/*
+ * checkRunes is a helper function to validate that input is not empty and every rune
+ * satisfies valid.
+ * @param input string
+ * @param valid func(rune) bool
+ * @return string reason, empty if valid
+ */
+func checkRunes(input string, valid func(rune) bool) string {
+	if input == "" {
+		return "empty"
+	}
+	for _, r := range input {
+		if !valid(r) {
+			return fmt.Sprintf("invalid character %q", r)
+		}
+	}
+	return ""
+}
+
+// checkAlpha is a helper function to validate letters only
+func checkAlpha(input string) string {
+	return checkRunes(input, unicode.IsLetter)
+}
+
+// checkAlphanumeric is a helper function to validate letters and digits only
+func checkAlphanumeric(input string) string {
+	return checkRunes(input, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	})
+}
+
+// checkASCII is a helper function to validate ASCII characters only
+func checkASCII(input string) string {
+	return checkRunes(input, func(r rune) bool {
+		return r <= unicode.MaxASCII
+	})
+}
+
+/*
+ * checkHex is a helper function to validate hexadecimal digits with an optional 0x prefix.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkHex(input string) string {
+	if strings.HasPrefix(input, "0x") || strings.HasPrefix(input, "0X") {
+		input = input[2:]
+	}
+	return checkRunes(input, func(r rune) bool {
+		return r <= unicode.MaxASCII && isHexDigit(byte(r))
+	})
+}
+
+/*
+ * checkBase64 is a helper function to validate padded base64 in the standard or the URL safe alphabet.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkBase64(input string) string {
+	if input == "" {
+		return "empty"
+	}
+	if len(input)%4 != 0 {
+		return "length is not a multiple of 4"
+	}
+	if _, err := base64.StdEncoding.Strict().DecodeString(input); err == nil {
+		return ""
+	}
+	if _, err := base64.URLEncoding.Strict().DecodeString(input); err == nil {
+		return ""
+	}
+	return "illegal base64 data"
+}
+
+/*
+ * checkJSON is a helper function to validate a JSON document.
+ * @param input string
+ * @return string reason, empty if valid
+ */
+func checkJSON(input string) string {
+	var value interface{}
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// isAlphaNumericASCII is a helper function to check for an ASCII letter or digit
+func isAlphaNumericASCII(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// isHexDigit is a helper function to check for a hexadecimal digit
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+/*
+ * is is a helper function to check the current value against a rule, false if a previous
+ * step recorded an error.
+ * @param i input
+ * @param check func(string) string
+ * @return bool
+ */
+func is(i input, check func(string) string) bool {
+	if i.err != nil {
+		return false
+	}
+	return check(getInput(i)) == ""
+}
+
+/*
+ * IsEmail checks if the value is an email address.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "jane.doe@example.com" => IsEmail() => true
+ */
+func (i *input) IsEmail() bool {
+	return is(*i, checkEmail)
+}
+
+/*
+ * IsURL checks if the value is an absolute URL with scheme and host.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "https://example.com/a?b=c" => IsURL() => true
+ */
+func (i *input) IsURL() bool {
+	return is(*i, checkURL)
+}
+
+/*
+ * IsUUID checks if the value is a canonical UUID of the given version.
+ * it can be chained on function which return StringManipulation interface
+ * @param version int UUID version, 0 accepts every version
+ * @return bool
+ * Example: "123e4567-e89b-42d3-a456-426614174000" => IsUUID(4) => true
+ */
+func (i *input) IsUUID(version int) bool {
+	return is(*i, func(input string) string {
+		return checkUUID(input, version)
+	})
+}
+
+/*
+ * IsIPv4 checks if the value is an IPv4 address in dotted decimal form.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "192.168.0.1" => IsIPv4() => true
+ */
+func (i *input) IsIPv4() bool {
+	return is(*i, checkIPv4)
+}
+
+/*
+ * IsIPv6 checks if the value is an IPv6 address.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "2001:db8::1" => IsIPv6() => true
+ */
+func (i *input) IsIPv6() bool {
+	return is(*i, checkIPv6)
+}
+
+/*
+ * IsHostname checks if the value is a RFC 1123 hostname.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "api.example.com" => IsHostname() => true
+ */
+func (i *input) IsHostname() bool {
+	return is(*i, checkHostname)
+}
+
+/*
+ * IsNumeric checks if the value is a decimal number with optional sign and fraction.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "-12.5" => IsNumeric() => true
+ */
+func (i *input) IsNumeric() bool {
+	return is(*i, checkNumeric)
+}
+
+/*
+ * IsAlpha checks if the value is not empty and consists of letters only.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "Größe" => IsAlpha() => true
+ */
+func (i *input) IsAlpha() bool {
+	return is(*i, checkAlpha)
+}
+
+/*
+ * IsAlphanumeric checks if the value is not empty and consists of letters and digits only.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "abc123" => IsAlphanumeric() => true
+ */
+func (i *input) IsAlphanumeric() bool {
+	return is(*i, checkAlphanumeric)
+}
+
+/*
+ * IsASCII checks if the value is not empty and consists of ASCII characters only.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "héllo" => IsASCII() => false
+ */
+func (i *input) IsASCII() bool {
+	return is(*i, checkASCII)
+}
+
+/*
+ * IsHex checks if the value consists of hexadecimal digits with an optional 0x prefix.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "0xFF" => IsHex() => true
+ */
+func (i *input) IsHex() bool {
+	return is(*i, checkHex)
+}
+
+/*
+ * IsBase64 checks if the value is padded base64 in the standard or the URL safe alphabet.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: "aGVsbG8=" => IsBase64() => true
+ */
+func (i *input) IsBase64() bool {
+	return is(*i, checkBase64)
+}
+
+/*
+ * IsJSON checks if the value is a valid JSON document.
+ * it can be chained on function which return StringManipulation interface
+ * @return bool
+ * Example: `{"a": 1}` => IsJSON() => true
+ */
+func (i *input) IsJSON() bool {
+	return is(*i, checkJSON)
+}
+
+/*
+ * Validate checks the value against rules in order and records a descriptive error for the
+ * first rule which fails. The error is an *OpError wrapping a *ValidationError, use
+ * errors.Is(err, ErrValidation) or errors.As to inspect it. The value is not modified.
+ * it can be chained on function which return StringManipulation interface
+ * @param rules ...Rule
+ * @return StringManipulation
+ * Example: New("jane.doe").Validate(RuleEmail).Error() => "stringy: Validate(email): invalid email: missing @"
+ */
+func (i *input) Validate(rules ...Rule) StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	for _, rule := range rules {
+		if reason := rule.Check(input); reason != "" {
+			i.err = newOpError("Validate", input, rule.Name, &ValidationError{Rule: rule.Name, Reason: reason})
+			return i
+		}
+	}
+	return i
+}
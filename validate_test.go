@@ -0,0 +1,135 @@
+package stringy
+
+import (
+	"errors"
+	"testing"
+)
+
+// Test the validation predicates
+func TestInput_IsPredicates(t *testing.T) {
+	testCases := []struct {
+		name      string
+		predicate func(sm StringManipulation) bool
+		valid     []string
+		invalid   []string
+	}{
+		{"IsEmail", StringManipulation.IsEmail,
+			[]string{"jane.doe@example.com", "a+tag@sub.example.co.uk", "x_y@example.org."},
+			[]string{"", "jane.doe", "@example.com", ".jane@example.com", "ja..ne@example.com", "jane@localhost", "jane@-example.com", "jäne@example.com"}},
+		{"IsURL", StringManipulation.IsURL,
+			[]string{"https://example.com", "http://127.0.0.1:8080/path?q=1", "ftp://[2001:db8::1]/file"},
+			[]string{"", "example.com", "/relative/path", "https://", "http://exa mple.com", "http://bad_host.com"}},
+		{"IsIPv4", StringManipulation.IsIPv4,
+			[]string{"192.168.0.1", "0.0.0.0", "255.255.255.255"},
+			[]string{"", "256.1.1.1", "1.2.3", "01.2.3.4", "1.2.3.4.5", "a.b.c.d", "::1"}},
+		{"IsIPv6", StringManipulation.IsIPv6,
+			[]string{"::1", "2001:db8::1", "fe80::1ff:fe23:4567:890a", "::ffff:192.0.2.1"},
+			[]string{"", "192.168.0.1", "2001:db8:::1", "fe80::1%eth0", "gggg::1"}},
+		{"IsHostname", StringManipulation.IsHostname,
+			[]string{"localhost", "api.example.com", "xn--bcher-kva.example", "example.com."},
+			[]string{"", "-example.com", "example-.com", "exa mple.com", "a..b", "under_score.com"}},
+		{"IsNumeric", StringManipulation.IsNumeric,
+			[]string{"0", "-12", "+3.14", ".5", "42."},
+			[]string{"", "-", ".", "1.2.3", "--1", "1e10", "12a"}},
+		{"IsAlpha", StringManipulation.IsAlpha,
+			[]string{"abc", "Müller", "日本"},
+			[]string{"", "abc1", "a b"}},
+		{"IsAlphanumeric", StringManipulation.IsAlphanumeric,
+			[]string{"abc123", "Müller1"},
+			[]string{"", "abc-123", "a b"}},
+		{"IsASCII", StringManipulation.IsASCII,
+			[]string{"hello, world!", "~"},
+			[]string{"", "héllo"}},
+		{"IsHex", StringManipulation.IsHex,
+			[]string{"deadBEEF", "0x1f", "0"},
+			[]string{"", "0x", "xyz", "12g"}},
+		{"IsBase64", StringManipulation.IsBase64,
+			[]string{"aGVsbG8=", "aGVsbG8gd29ybGQ=", "-_-_"},
+			[]string{"", "aGVsbG8", "aGVs bG8=", "aGVsbG9="}},
+		{"IsJSON", StringManipulation.IsJSON,
+			[]string{`{"a": [1, 2]}`, `"text"`, "null", " 1 "},
+			[]string{"", "{a: 1}", `{"a": 1`, "[1,]"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, val := range tc.valid {
+				if !tc.predicate(New(val)) {
+					t.Errorf("Expected %q to be valid", val)
+				}
+			}
+			for _, val := range tc.invalid {
+				if tc.predicate(New(val)) {
+					t.Errorf("Expected %q to be invalid", val)
+				}
+			}
+		})
+	}
+}
+
+// Test UUID validation with versions
+func TestInput_IsUUID(t *testing.T) {
+	testCases := []struct {
+		input    string
+		version  int
+		expected bool
+	}{
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", 0, true},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", 4, true},
+		{"F47AC10B-58CC-4372-A567-0E02B2C3D479", 4, true},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", 1, false},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", 1, true},
+		{"01890a5d-ac96-774b-bcce-b302099a8057", 7, true},
+		{"f47ac10b-58cc-4372-c567-0e02b2c3d479", 0, false},
+		{"f47ac10b-58cc-0372-a567-0e02b2c3d479", 0, false},
+		{"f47ac10b58cc4372a5670e02b2c3d479", 0, false},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d47z", 0, false},
+	}
+	for _, tc := range testCases {
+		if val := New(tc.input).IsUUID(tc.version); val != tc.expected {
+			t.Errorf("IsUUID(%q, %d) - Expected: %v but got: %v", tc.input, tc.version, tc.expected, val)
+		}
+	}
+}
+
+// Test Validate records a descriptive error for the first failing rule
+func TestInput_Validate(t *testing.T) {
+	str := New("jane.doe@example.com").Validate(RuleASCII, RuleEmail)
+	if err := str.Error(); err != nil {
+		t.Errorf("Expected no error but got: %v", err)
+	}
+	if val := str.Get(); val != "jane.doe@example.com" {
+		t.Errorf("Expected the value to be unchanged but got: %s", val)
+	}
+
+	err := New("jane.doe").Validate(RuleASCII, RuleEmail, RuleUUID(4)).Error()
+	expected := "stringy: Validate(email): invalid email: missing @"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected: %s but got: %v", expected, err)
+	}
+	if !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected errors.Is(ErrValidation) but got: %v", err)
+	}
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Rule != "email" || validationErr.Reason != "missing @" {
+		t.Errorf("Expected a ValidationError for email but got: %#v", validationErr)
+	}
+
+	err = New("f47ac10b-58cc-4372-a567-0e02b2c3d479").Validate(RuleUUID(1)).Error()
+	expected = "stringy: Validate(uuid v1): invalid uuid v1: version is 4, expected 1"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected: %s but got: %v", expected, err)
+	}
+
+	even := Rule{Name: "even length", Check: func(s string) string {
+		if len(s)%2 != 0 {
+			return "odd length"
+		}
+		return ""
+	}}
+	if err := New("abc").Trim().Validate(even).Error(); !errors.Is(err, ErrValidation) {
+		t.Errorf("Expected custom rule to fail but got: %v", err)
+	}
+	if err := Of("abcd").Validate(even, RuleAlpha).Error(); err != nil {
+		t.Errorf("Expected no error but got: %v", err)
+	}
+}
@@ -0,0 +1,283 @@
+package stringy
+
+/*
+ * S is an immutable string value offering the chainable transforms of StringManipulation
+ * with value semantics. Every transform returns a new S and never modifies the receiver,
+ * so a value can be branched into several chains or read from multiple goroutines
+ * without any synchronization. S is never pooled and does not need to be released.
+ * Example: base := stringy.Of(" Hello World ").Trim()
+ * base.SnakeCase().Get() => "Hello_World"
+ * base.KebabCase().Get() => "Hello-World"
+ */
+type S struct {
+	in input
+}
+
+/*
+ * Of is a constructor function that creates a new immutable S
+ * initialized with the provided string value.
+ * @param val string
+ * @return S
+ */
+func Of(val string) S {
+	return S{in: input{Input: val}}
+}
+
+/*
+ * Chain returns a new StringManipulation holding the state of s, giving access to the
+ * methods which are not available on S. Changes made through it are not reflected in s.
+ * @return StringManipulation
+ * Example: Of("hello world").SnakeCase().Chain().First(3) => "hel"
+ */
+func (s S) Chain() StringManipulation {
+	c := inputPool.Get().(*input)
+	*c = s.in
+	return c
+}
+
+/*
+ * Error returns the error recorded by a previous transform, if any.
+ * @return error
+ */
+func (s S) Error() error {
+	return s.in.err
+}
+
+/*
+ * Get returns the current string value.
+ * @return string
+ * Note: If there was an error during string manipulation, it returns an empty string.
+ */
+func (s S) Get() string {
+	return getInput(s.in)
+}
+
+/*
+ * GetE returns the current string value together with the error of the chain.
+ * @return string
+ * @return error
+ */
+func (s S) GetE() (string, error) {
+	return getInput(s.in), s.in.err
+}
+
+/*
+ * MustGet returns the current string value and panics if a transform recorded an error.
+ * @return string
+ */
+func (s S) MustGet() string {
+	if s.in.err != nil {
+		panic(s.in.err)
+	}
+	return getInput(s.in)
+}
+
+/*
+ * String implements fmt.Stringer and returns the same value as Get.
+ * @return string
+ */
+func (s S) String() string {
+	return s.Get()
+}
+
+// Acronym returns a new S holding the acronym of s, see StringManipulation.Acronym
+func (s S) Acronym() S {
+	s.in.Acronym()
+	return s
+}
+
+// Between returns a new S holding the text between start and end, see StringManipulation.Between
+func (s S) Between(start, end string, opts ...MatchOption) S {
+	s.in.Between(start, end, opts...)
+	return s
+}
+
+// CamelCase returns a new S in camel case form, see StringManipulation.CamelCase
+func (s S) CamelCase(rule ...string) S {
+	s.in.CamelCase(rule...)
+	return s
+}
+
+// Delimited returns a new S joined by delimiter, see StringManipulation.Delimited
+func (s S) Delimited(delimiter string, rule ...string) S {
+	s.in.Delimited(delimiter, rule...)
+	return s
+}
+
+// ExpandEnv returns a new S with environment variables expanded, see StringManipulation.ExpandEnv
+func (s S) ExpandEnv(opts ...InterpolateOption) S {
+	s.in.ExpandEnv(opts...)
+	return s
+}
+
+// FromRoman returns a new S with the decimal value of a roman numeral, see StringManipulation.FromRoman
+func (s S) FromRoman() S {
+	s.in.FromRoman()
+	return s
+}
+
+// Format returns a new S with the human readable form of the identifier, see StringManipulation.Format
+func (s S) Format(id Identifier) S {
+	s.in.Format(id)
+	return s
+}
+
+// Interpolate returns a new S with the placeholders replaced by vars, see StringManipulation.Interpolate
+func (s S) Interpolate(vars map[string]string, opts ...InterpolateOption) S {
+	s.in.Interpolate(vars, opts...)
+	return s
+}
+
+// InterpolateWith returns a new S with the placeholders resolved by resolver, see StringManipulation.InterpolateWith
+func (s S) InterpolateWith(resolver Resolver, opts ...InterpolateOption) S {
+	s.in.InterpolateWith(resolver, opts...)
+	return s
+}
+
+// KebabCase returns a new S in kebab case form, see StringManipulation.KebabCase
+func (s S) KebabCase(rule ...string) S {
+	s.in.KebabCase(rule...)
+	return s
+}
+
+// Mask returns a new S with all but the kept characters masked, see StringManipulation.Mask
+func (s S) Mask(keepStart, keepEnd int, maskRune rune) S {
+	s.in.Mask(keepStart, keepEnd, maskRune)
+	return s
+}
+
+// Normalize returns a new S with the compact form of the identifier, see StringManipulation.Normalize
+func (s S) Normalize(id Identifier) S {
+	s.in.Normalize(id)
+	return s
+}
+
+// NumberToWords returns a new S with the number spelled out, see StringManipulation.NumberToWords
+func (s S) NumberToWords() S {
+	s.in.NumberToWords()
+	return s
+}
+
+// OrdinalToWords returns a new S with the ordinal spelled out, see StringManipulation.OrdinalToWords
+func (s S) OrdinalToWords() S {
+	s.in.OrdinalToWords()
+	return s
+}
+
+// Ordinalize returns a new S with the ordinal suffix appended, see StringManipulation.Ordinalize
+func (s S) Ordinalize() S {
+	s.in.Ordinalize()
+	return s
+}
+
+// PascalCase returns a new S in pascal case form, see StringManipulation.PascalCase
+func (s S) PascalCase(rule ...string) S {
+	s.in.PascalCase(rule...)
+	return s
+}
+
+// Pluralize returns a new S with the last word in plural form, see StringManipulation.Pluralize
+func (s S) Pluralize() S {
+	s.in.Pluralize()
+	return s
+}
+
+// Redact returns a new S with detected PII replaced, see StringManipulation.Redact
+func (s S) Redact(redactor *Redactor) S {
+	s.in.Redact(redactor)
+	return s
+}
+
+// ReplaceAll returns a new S with every search replaced by replace, see StringManipulation.ReplaceAll
+func (s S) ReplaceAll(search, replace string, opts ...MatchOption) S {
+	s.in.ReplaceAll(search, replace, opts...)
+	return s
+}
+
+// ReplaceMany returns a new S with every key of pairs replaced by its value, see StringManipulation.ReplaceMany
+func (s S) ReplaceMany(pairs map[string]string, opts ...MatchOption) S {
+	s.in.ReplaceMany(pairs, opts...)
+	return s
+}
+
+// ReplaceRegex returns a new S with the matches of pattern replaced, see StringManipulation.ReplaceRegex
+func (s S) ReplaceRegex(pattern, repl string) S {
+	s.in.ReplaceRegex(pattern, repl)
+	return s
+}
+
+// ReplaceRegexFunc returns a new S with the matches of pattern replaced by fn, see StringManipulation.ReplaceRegexFunc
+func (s S) ReplaceRegexFunc(pattern string, fn func(match string) string) S {
+	s.in.ReplaceRegexFunc(pattern, fn)
+	return s
+}
+
+// ReplaceWith returns a new S with the matches of replacer replaced, see StringManipulation.ReplaceWith
+func (s S) ReplaceWith(replacer *Replacer) S {
+	s.in.ReplaceWith(replacer)
+	return s
+}
+
+// SentenceCase returns a new S in sentence case form, see StringManipulation.SentenceCase
+func (s S) SentenceCase(rule ...string) S {
+	s.in.SentenceCase(rule...)
+	return s
+}
+
+// Singularize returns a new S with the last word in singular form, see StringManipulation.Singularize
+func (s S) Singularize() S {
+	s.in.Singularize()
+	return s
+}
+
+// SlugifyWithCount returns a new S holding the slug of s, see StringManipulation.SlugifyWithCount
+func (s S) SlugifyWithCount(count int) S {
+	s.in.SlugifyWithCount(count)
+	return s
+}
+
+// SnakeCase returns a new S in snake case form, see StringManipulation.SnakeCase
+func (s S) SnakeCase(rule ...string) S {
+	s.in.SnakeCase(rule...)
+	return s
+}
+
+// Substring returns a new S holding the runes between start and end, see StringManipulation.Substring
+func (s S) Substring(start, end int) S {
+	s.in.Substring(start, end)
+	return s
+}
+
+// ToRoman returns a new S with the roman numeral of the number, see StringManipulation.ToRoman
+func (s S) ToRoman() S {
+	s.in.ToRoman()
+	return s
+}
+
+// Trim returns a new S without leading and trailing cutset, see StringManipulation.Trim
+func (s S) Trim(cutset ...string) S {
+	s.in.Trim(cutset...)
+	return s
+}
+
+// TruncateWords returns a new S truncated to count words, see StringManipulation.TruncateWords
+func (s S) TruncateWords(count int, suffix string) S {
+	s.in.TruncateWords(count, suffix)
+	return s
+}
+
+// Validate returns a new S recording an error for the first rule which fails, see StringManipulation.Validate
+func (s S) Validate(rules ...Rule) S {
+	s.in.Validate(rules...)
+	return s
+}
+
+// ToLower returns the value of s in lowercase, see StringManipulation.ToLower
+func (s S) ToLower() string {
+	return s.in.ToLower()
+}
+
+// ToUpper returns the value of s in uppercase, see StringManipulation.ToUpper
+func (s S) ToUpper() string {
+	return s.in.ToUpper()
+}
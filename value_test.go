@@ -0,0 +1,90 @@
+package stringy
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// Test S - branching a chain keeps every branch independent
+func TestS_Branching(t *testing.T) {
+	base := Of("  Hello World  ").Trim()
+	snake := base.SnakeCase()
+	kebab := base.KebabCase()
+
+	if val := base.Get(); val != "Hello World" {
+		t.Errorf("Expected: %s but got: %s", "Hello World", val)
+	}
+	if val := snake.Get(); val != "Hello_World" {
+		t.Errorf("Expected: %s but got: %s", "Hello_World", val)
+	}
+	if val := kebab.ToLower(); val != "hello-world" {
+		t.Errorf("Expected: %s but got: %s", "hello-world", val)
+	}
+	if val := fmt.Sprint(snake); val != "Hello_World" {
+		t.Errorf("Expected String(): %s but got: %s", "Hello_World", val)
+	}
+}
+
+// Test S - errors stay on the branch which produced them
+func TestS_Error(t *testing.T) {
+	base := Of("hello world")
+	failed := base.CamelCase("%")
+	if failed.Error() == nil {
+		t.Errorf("Expected error but got nil")
+	}
+	if failed.Get() != "" {
+		t.Errorf("Expected empty result after error but got: %s", failed.Get())
+	}
+	if base.Error() != nil {
+		t.Errorf("Expected no error on base but got: %v", base.Error())
+	}
+	if val := base.CamelCase().Get(); val != "helloWorld" {
+		t.Errorf("Expected: %s but got: %s", "helloWorld", val)
+	}
+}
+
+// Test S - Chain gives an independent StringManipulation
+func TestS_Chain(t *testing.T) {
+	base := Of("hello world").SnakeCase()
+	chain := base.Chain()
+	if val := chain.First(3); val != "hel" {
+		t.Errorf("Expected: %s but got: %s", "hel", val)
+	}
+	chain.First(100)
+	if chain.Error() == nil {
+		t.Errorf("Expected error on chain but got nil")
+	}
+	if base.Error() != nil {
+		t.Errorf("Expected base to be unaffected but got: %v", base.Error())
+	}
+}
+
+// Test S - concurrent reads and branches of a shared value
+func TestS_Concurrency(t *testing.T) {
+	shared := Of("ThisIsTest")
+	const goroutines = 50
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func(id int) {
+			defer wg.Done()
+			if id%2 == 0 {
+				if val := shared.SnakeCase().ToLower(); val != "this_is_test" {
+					t.Errorf("Expected: %s but got: %s", "this_is_test", val)
+				}
+			} else {
+				if val := shared.KebabCase().ToLower(); val != "this-is-test" {
+					t.Errorf("Expected: %s but got: %s", "this-is-test", val)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if val := shared.Get(); val != "ThisIsTest" {
+		t.Errorf("Expected shared value to be unchanged but got: %s", val)
+	}
+	if shared.Error() != nil {
+		t.Errorf("Expected no error but got: %v", shared.Error())
+	}
+}
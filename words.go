@@ -0,0 +1,324 @@
+package stringy
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Currency holds the names used to spell out an amount of money
+type Currency struct {
+	Major       string // e.g. "dollar"
+	MajorPlural string // e.g. "dollars"
+	Minor       string // e.g. "cent"
+	MinorPlural string // e.g. "cents"
+	MinorUnits  int64  // minor units per major unit, e.g. 100
+}
+
+// Currencies which can be passed to CurrencyToWords
+var (
+	CurrencyUSD = Currency{"dollar", "dollars", "cent", "cents", 100}
+	CurrencyEUR = Currency{"euro", "euros", "cent", "cents", 100}
+	CurrencyGBP = Currency{"pound", "pounds", "penny", "pence", 100}
+)
+
+var smallNumberWords = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+	"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var tensWords = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+var scaleWords = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+
+// ordinalWords maps the last word of a cardinal number to its ordinal form when it is irregular
+var ordinalWords = map[string]string{
+	"one": "first", "two": "second", "three": "third", "five": "fifth",
+	"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+}
+
+// romanNumerals holds the roman symbols by descending value
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+	{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// romanRegexp matches canonical roman numerals from 1 to 3999
+var romanRegexp = regexp.MustCompile(`^M{0,3}(CM|CD|D?C{0,3})(XC|XL|L?X{0,3})(IX|IV|V?I{0,3})$`)
+
+/*
+ * hundredsToWords is a helper function to spell out a number between 0 and 999.
+ * @param n int64
+ * @return []string words, empty for 0
+ */
+func hundredsToWords(n int64) []string {
+	var words []string
+	if n >= 100 {
+		words = append(words, smallNumberWords[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, smallNumberWords[n])
+	case n%10 == 0:
+		words = append(words, tensWords[n/10])
+	default:
+		words = append(words, tensWords[n/10]+"-"+smallNumberWords[n%10])
+	}
+	return words
+}
+
+/*
+ * NumberToWords spells out n in English words using the short scale.
+ * @param n int64
+ * @return string
+ * Example: NumberToWords(1234) => "one thousand two hundred thirty-four"
+ * NumberToWords(-7) => "minus seven"
+ */
+func NumberToWords(n int64) string {
+	if n < 0 {
+		return "minus " + magnitudeToWords(magnitude(n))
+	}
+	return magnitudeToWords(uint64(n))
+}
+
+/*
+ * magnitude is a helper function to return the absolute value of n as uint64, so
+ * math.MinInt64 does not overflow.
+ * @param n int64
+ * @return uint64
+ */
+func magnitude(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
+
+/*
+ * magnitudeToWords is a helper function to spell out a non negative number in English words.
+ * @param n uint64
+ * @return string
+ */
+func magnitudeToWords(n uint64) string {
+	if n == 0 {
+		return smallNumberWords[0]
+	}
+	var groups [][]string
+	for scale := 0; n > 0; scale++ {
+		if group := n % 1000; group > 0 {
+			words := hundredsToWords(int64(group))
+			if scaleWords[scale] != "" {
+				words = append(words, scaleWords[scale])
+			}
+			groups = append(groups, words)
+		}
+		n /= 1000
+	}
+	var words []string
+	for idx := len(groups) - 1; idx >= 0; idx-- {
+		words = append(words, groups[idx]...)
+	}
+	return strings.Join(words, " ")
+}
+
+/*
+ * OrdinalToWords spells out n as an English ordinal.
+ * @param n int64
+ * @return string
+ * Example: OrdinalToWords(21) => "twenty-first", OrdinalToWords(100) => "one hundredth"
+ */
+func OrdinalToWords(n int64) string {
+	words := NumberToWords(n)
+	// only the last part of the last word changes, e.g. "twenty-one" => "twenty-first"
+	cut := strings.LastIndexAny(words, " -") + 1
+	head, last := words[:cut], words[cut:]
+	if ordinal, ok := ordinalWords[last]; ok {
+		return head + ordinal
+	}
+	if strings.HasSuffix(last, "y") {
+		return head + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return head + last + "th"
+}
+
+/*
+ * CurrencyToWords spells out an amount given in minor units (e.g. cents) in English words.
+ * @param amount int64 amount in minor units
+ * @param currency Currency names of the currency
+ * @return string
+ * Note: The minor part is left out when it is zero.
+ * Example: CurrencyToWords(123456, CurrencyUSD) => "one thousand two hundred thirty-four dollars and fifty-six cents"
+ */
+func CurrencyToWords(amount int64, currency Currency) string {
+	units := currency.MinorUnits
+	if units <= 0 {
+		units = 100
+	}
+	prefix := ""
+	if amount < 0 {
+		prefix = "minus "
+	}
+	// split the magnitude as uint64 so math.MinInt64 does not overflow
+	abs := magnitude(amount)
+	major, minor := abs/uint64(units), abs%uint64(units)
+
+	majorName := currency.MajorPlural
+	if major == 1 {
+		majorName = currency.Major
+	}
+	result := prefix + magnitudeToWords(major) + " " + majorName
+	if minor == 0 {
+		return result
+	}
+	minorName := currency.MinorPlural
+	if minor == 1 {
+		minorName = currency.Minor
+	}
+	return result + " and " + magnitudeToWords(minor) + " " + minorName
+}
+
+/*
+ * ToRoman converts n to a roman numeral.
+ * @param n int between 1 and 3999
+ * @return string
+ * @return error ErrOutOfRange wrapped in *OpError if n can not be represented
+ * Example: ToRoman(1994) => "MCMXCIV", nil
+ */
+func ToRoman(n int) (string, error) {
+	if n < 1 || n > 3999 {
+		return "", newOpError("ToRoman", strconv.Itoa(n), n, ErrOutOfRange)
+	}
+	var result strings.Builder
+	for _, numeral := range romanNumerals {
+		for n >= numeral.value {
+			result.WriteString(numeral.symbol)
+			n -= numeral.value
+		}
+	}
+	return result.String(), nil
+}
+
+/*
+ * FromRoman converts a roman numeral to an integer. Only canonical numerals are accepted,
+ * "IIII" or "IC" are invalid. Surrounding whitespace and lowercase letters are tolerated.
+ * @param val string
+ * @return int
+ * @return error ErrInvalidRoman wrapped in *OpError if val is not a valid roman numeral
+ * Example: FromRoman("MCMXCIV") => 1994, nil
+ */
+func FromRoman(val string) (int, error) {
+	numeral := strings.ToUpper(strings.TrimSpace(val))
+	if numeral == "" || !romanRegexp.MatchString(numeral) {
+		return 0, newOpError("FromRoman", val, nil, ErrInvalidRoman)
+	}
+	result := 0
+	for _, r := range romanNumerals {
+		for strings.HasPrefix(numeral, r.symbol) {
+			result += r.value
+			numeral = numeral[len(r.symbol):]
+		}
+	}
+	return result, nil
+}
+
+/*
+* NumberToWords spells out a numeric input in English words
+* it can be chained on function which return StringManipulation interface
+* @return StringManipulation
+* Note: If the input is not an integer, it sets an error.
+* Example: "1,234" => "one thousand two hundred thirty-four"
+ */
+func (i *input) NumberToWords() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	n, err := parseInt(input)
+	if err != nil {
+		i.err = newOpError("NumberToWords", input, nil, err)
+		setResult(i, "")
+		return i
+	}
+	setResult(i, NumberToWords(n))
+	return i
+}
+
+/*
+* OrdinalToWords spells out a numeric input as an English ordinal
+* it can be chained on function which return StringManipulation interface
+* @return StringManipulation
+* Note: If the input is not an integer, it sets an error.
+* Example: "21" => "twenty-first"
+ */
+func (i *input) OrdinalToWords() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	n, err := parseInt(input)
+	if err != nil {
+		i.err = newOpError("OrdinalToWords", input, nil, err)
+		setResult(i, "")
+		return i
+	}
+	setResult(i, OrdinalToWords(n))
+	return i
+}
+
+/*
+* ToRoman converts a numeric input to a roman numeral
+* it can be chained on function which return StringManipulation interface
+* @return StringManipulation
+* Note: If the input is not an integer between 1 and 3999, it sets an error.
+* Example: "2024" => "MMXXIV"
+ */
+func (i *input) ToRoman() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	input := getInput(*i)
+	n, err := parseInt(input)
+	if err != nil {
+		i.err = newOpError("ToRoman", input, nil, err)
+		setResult(i, "")
+		return i
+	}
+	if n < 1 || n > 3999 {
+		i.err = newOpError("ToRoman", input, n, ErrOutOfRange)
+		setResult(i, "")
+		return i
+	}
+	roman, _ := ToRoman(int(n))
+	setResult(i, roman)
+	return i
+}
+
+/*
+* FromRoman converts a roman numeral input to its decimal representation
+* it can be chained on function which return StringManipulation interface
+* @return StringManipulation
+* Note: If the input is not a valid roman numeral, it sets an error.
+* Example: "MMXXIV" => "2024"
+ */
+func (i *input) FromRoman() StringManipulation {
+	if i.err != nil {
+		return i
+	}
+
+	n, err := FromRoman(getInput(*i))
+	if err != nil {
+		i.err = err
+		setResult(i, "")
+		return i
+	}
+	setResult(i, strconv.Itoa(n))
+	return i
+}
@@ -0,0 +1,139 @@
+package stringy
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// Test NumberToWords
+func TestNumberToWords(t *testing.T) {
+	testCases := map[int64]string{
+		0:             "zero",
+		7:             "seven",
+		13:            "thirteen",
+		40:            "forty",
+		99:            "ninety-nine",
+		100:           "one hundred",
+		101:           "one hundred one",
+		1234:          "one thousand two hundred thirty-four",
+		1000000:       "one million",
+		1000001:       "one million one",
+		2500000015:    "two billion five hundred million fifteen",
+		-42:           "minus forty-two",
+		math.MaxInt64: "nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven",
+		math.MinInt64: "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight",
+	}
+	for n, expected := range testCases {
+		if val := NumberToWords(n); val != expected {
+			t.Errorf("NumberToWords(%d) - Expected: %s but got: %s", n, expected, val)
+		}
+	}
+}
+
+// Test OrdinalToWords
+func TestOrdinalToWords(t *testing.T) {
+	testCases := map[int64]string{
+		0:    "zeroth",
+		1:    "first",
+		2:    "second",
+		3:    "third",
+		5:    "fifth",
+		8:    "eighth",
+		9:    "ninth",
+		12:   "twelfth",
+		20:   "twentieth",
+		21:   "twenty-first",
+		100:  "one hundredth",
+		1003: "one thousand third",
+	}
+	for n, expected := range testCases {
+		if val := OrdinalToWords(n); val != expected {
+			t.Errorf("OrdinalToWords(%d) - Expected: %s but got: %s", n, expected, val)
+		}
+	}
+}
+
+// Test CurrencyToWords
+func TestCurrencyToWords(t *testing.T) {
+	testCases := []struct {
+		amount   int64
+		currency Currency
+		expected string
+	}{
+		{123456, CurrencyUSD, "one thousand two hundred thirty-four dollars and fifty-six cents"},
+		{100, CurrencyUSD, "one dollar"},
+		{101, CurrencyEUR, "one euro and one cent"},
+		{5, CurrencyGBP, "zero pounds and five pence"},
+		{-250, CurrencyUSD, "minus two dollars and fifty cents"},
+		{math.MinInt64, CurrencyUSD, "minus ninety-two quadrillion two hundred thirty-three trillion seven hundred twenty billion three hundred sixty-eight million five hundred forty-seven thousand seven hundred fifty-eight dollars and eight cents"},
+		{math.MinInt64, Currency{"yen", "yen", "", "", 1}, "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight yen"},
+	}
+	for _, tc := range testCases {
+		if val := CurrencyToWords(tc.amount, tc.currency); val != tc.expected {
+			t.Errorf("CurrencyToWords(%d) - Expected: %s but got: %s", tc.amount, tc.expected, val)
+		}
+	}
+}
+
+// Test ToRoman and FromRoman
+func TestRoman(t *testing.T) {
+	testCases := map[int]string{
+		1: "I", 4: "IV", 9: "IX", 14: "XIV", 40: "XL", 90: "XC", 400: "CD",
+		1994: "MCMXCIV", 2024: "MMXXIV", 3999: "MMMCMXCIX",
+	}
+	for n, roman := range testCases {
+		if val, err := ToRoman(n); val != roman || err != nil {
+			t.Errorf("ToRoman(%d) - Expected: %s but got: %s, %v", n, roman, val, err)
+		}
+		if val, err := FromRoman(roman); val != n || err != nil {
+			t.Errorf("FromRoman(%s) - Expected: %d but got: %d, %v", roman, n, val, err)
+		}
+	}
+	if val, err := FromRoman(" mmxxiv "); val != 2024 || err != nil {
+		t.Errorf("FromRoman lowercase - Expected: 2024 but got: %d, %v", val, err)
+	}
+
+	for _, n := range []int{0, -1, 4000} {
+		if _, err := ToRoman(n); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("ToRoman(%d) - Expected ErrOutOfRange but got: %v", n, err)
+		}
+	}
+	for _, roman := range []string{"", "IIII", "IC", "VX", "MMMM", "ABC"} {
+		if _, err := FromRoman(roman); !errors.Is(err, ErrInvalidRoman) {
+			t.Errorf("FromRoman(%q) - Expected ErrInvalidRoman but got: %v", roman, err)
+		}
+	}
+}
+
+// Test number word chain methods
+func TestInput_NumberWords(t *testing.T) {
+	if val := New("1,234").NumberToWords().Get(); val != "one thousand two hundred thirty-four" {
+		t.Errorf("NumberToWords - Expected: one thousand two hundred thirty-four but got: %s", val)
+	}
+	if val := New("21").OrdinalToWords().Get(); val != "twenty-first" {
+		t.Errorf("OrdinalToWords - Expected: twenty-first but got: %s", val)
+	}
+	if val := New("2024").ToRoman().Get(); val != "MMXXIV" {
+		t.Errorf("ToRoman - Expected: MMXXIV but got: %s", val)
+	}
+	if val := New("MMXXIV").FromRoman().Get(); val != "2024" {
+		t.Errorf("FromRoman - Expected: 2024 but got: %s", val)
+	}
+	if val := Of("12").NumberToWords().SnakeCase().Get(); val != "twelve" {
+		t.Errorf("S NumberToWords - Expected: twelve but got: %s", val)
+	}
+
+	sm := New("5000").ToRoman()
+	if !errors.Is(sm.Error(), ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange but got: %v", sm.Error())
+	}
+	sm = New("IIII").FromRoman()
+	if !errors.Is(sm.Error(), ErrInvalidRoman) {
+		t.Errorf("Expected ErrInvalidRoman but got: %v", sm.Error())
+	}
+	sm = New("many").NumberToWords()
+	if !errors.Is(sm.Error(), ErrInvalidNumber) {
+		t.Errorf("Expected ErrInvalidNumber but got: %v", sm.Error())
+	}
+}